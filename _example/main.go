@@ -27,7 +27,7 @@ func (u User) Validate(ctx context.Context, v *validation.Validator) error {
 			u.Email,
 			constraint.IsNotBlank(),
 			constraint.HasMaxLength(255),
-			constraint.Matches(regexp.MustCompile(`.+@.+\..+`)),
+			constraint.IsEmail(),
 		),
 
 		validation.ComparableProperty(
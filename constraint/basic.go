@@ -2,8 +2,10 @@ package constraint
 
 import (
 	"context"
+	"strconv"
 	"time"
 
+	"line/message"
 	"line/validation"
 )
 
@@ -35,6 +37,14 @@ func (c NotBlankConstraint[T]) WithAllowedNil() NotBlankConstraint[T] {
 	return c
 }
 
+func (c NotBlankConstraint[T]) Validate(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *T,
+) error {
+	return c.ValidateComparable(ctx, validator, value)
+}
+
 func (c NotBlankConstraint[T]) ValidateString(
 	ctx context.Context,
 	validator *validation.Validator,
@@ -52,7 +62,7 @@ func (c NotBlankConstraint[T]) ValidateString(
 		return nil
 	}
 
-	return c.NewViolation(ctx, validator)
+	return c.newViolation(ctx, validator)
 }
 
 func (c NotBlankConstraint[T]) ValidateComparable(
@@ -72,7 +82,7 @@ func (c NotBlankConstraint[T]) ValidateComparable(
 		return nil
 	}
 
-	return c.NewViolation(ctx, validator)
+	return c.newViolation(ctx, validator)
 }
 
 func (c NotBlankConstraint[T]) ValidateBool(
@@ -92,7 +102,7 @@ func (c NotBlankConstraint[T]) ValidateBool(
 		return nil
 	}
 
-	return c.NewViolation(ctx, validator)
+	return c.newViolation(ctx, validator)
 }
 
 func (c NotBlankConstraint[T]) ValidateTime(
@@ -112,7 +122,7 @@ func (c NotBlankConstraint[T]) ValidateTime(
 		return nil
 	}
 
-	return c.NewViolation(ctx, validator)
+	return c.newViolation(ctx, validator)
 }
 
 func (c NotBlankConstraint[T]) ValidateCountable(
@@ -124,7 +134,29 @@ func (c NotBlankConstraint[T]) ValidateCountable(
 		return nil
 	}
 
-	return c.NewViolation(ctx, validator)
+	return c.newViolation(ctx, validator)
+}
+
+func (c NotBlankConstraint[T]) newViolation(
+	ctx context.Context,
+	validator *validation.Validator,
+) validation.Violation {
+	field := ""
+	if element := validator.PropertyPath().Last(); element != nil {
+		field = element.String()
+	}
+
+	template := c.MessageTemplate
+	if field != "" && template == validation.ErrIsBlank.Message() {
+		template = message.IsBlankField
+	}
+
+	return validator.
+		BuildViolation(ctx, c.Err, template).
+		WithParameters(
+			c.Parameters.Prepend(validation.StringParam("{{ field }}", field))...,
+		).
+		Create()
 }
 
 type BlankConstraint[T comparable] struct {
@@ -149,6 +181,14 @@ func IsBlankComparable[T comparable]() BlankConstraint[T] {
 	}
 }
 
+func (c BlankConstraint[T]) Validate(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *T,
+) error {
+	return c.ValidateComparable(ctx, validator, value)
+}
+
 func (c BlankConstraint[T]) ValidateString(
 	ctx context.Context,
 	validator *validation.Validator,
@@ -338,3 +378,101 @@ func (c NilConstraint[T]) ValidateTime(
 ) error {
 	return c.ValidateNil(ctx, validator, value == nil)
 }
+
+type TrueConstraint struct {
+	validation.BaseConstraint
+}
+
+func IsTrue() TrueConstraint {
+	return TrueConstraint{
+		BaseConstraint: validation.BaseConstraint{
+			Err:             validation.ErrNotTrue,
+			MessageTemplate: validation.ErrNotTrue.Message(),
+		},
+	}
+}
+
+func (c TrueConstraint) When(condition bool) TrueConstraint {
+	c.BaseConstraint = c.BaseConstraint.When(condition)
+	return c
+}
+
+func (c TrueConstraint) WhenGroups(groups ...string) TrueConstraint {
+	c.BaseConstraint = c.BaseConstraint.WhenGroups(groups...)
+	return c
+}
+
+func (c TrueConstraint) WithError(err error) TrueConstraint {
+	c.BaseConstraint = c.BaseConstraint.WithError(err)
+	return c
+}
+
+func (c TrueConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) TrueConstraint {
+	c.BaseConstraint = c.BaseConstraint.WithMessage(template, parameters...)
+
+	return c
+}
+
+func (c TrueConstraint) ValidateBool(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *bool,
+) error {
+	if c.ShouldSkip(validator) || value == nil || *value {
+		return nil
+	}
+
+	return c.NewViolationWithValue(ctx, validator, strconv.FormatBool(*value))
+}
+
+type FalseConstraint struct {
+	validation.BaseConstraint
+}
+
+func IsFalse() FalseConstraint {
+	return FalseConstraint{
+		BaseConstraint: validation.BaseConstraint{
+			Err:             validation.ErrNotFalse,
+			MessageTemplate: validation.ErrNotFalse.Message(),
+		},
+	}
+}
+
+func (c FalseConstraint) When(condition bool) FalseConstraint {
+	c.BaseConstraint = c.BaseConstraint.When(condition)
+	return c
+}
+
+func (c FalseConstraint) WhenGroups(groups ...string) FalseConstraint {
+	c.BaseConstraint = c.BaseConstraint.WhenGroups(groups...)
+	return c
+}
+
+func (c FalseConstraint) WithError(err error) FalseConstraint {
+	c.BaseConstraint = c.BaseConstraint.WithError(err)
+	return c
+}
+
+func (c FalseConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) FalseConstraint {
+	c.BaseConstraint = c.BaseConstraint.WithMessage(template, parameters...)
+
+	return c
+}
+
+func (c FalseConstraint) ValidateBool(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *bool,
+) error {
+	if c.ShouldSkip(validator) || value == nil || !*value {
+		return nil
+	}
+
+	return c.NewViolationWithValue(ctx, validator, strconv.FormatBool(*value))
+}
@@ -118,3 +118,22 @@ func (c ChoiceConstraint[T]) ValidateComparable(
 		).
 		Create()
 }
+
+func (c ChoiceConstraint[T]) ValidateComparables(
+	ctx context.Context,
+	validator *validation.Validator,
+	values []T,
+) error {
+	violations := validation.NewViolationList()
+
+	for i := range values {
+		err := violations.AppendFromError(
+			c.ValidateComparable(ctx, validator.AtIndex(i), &values[i]),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return violations.AsError()
+}
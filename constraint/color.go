@@ -0,0 +1,134 @@
+package constraint
+
+import (
+	"context"
+
+	"line/predicate"
+	"line/validation"
+)
+
+type HexColorConstraint struct {
+	err               error
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsHexColor() HexColorConstraint {
+	return HexColorConstraint{
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c HexColorConstraint) WithError(err error) HexColorConstraint {
+	c.err = err
+	return c
+}
+
+func (c HexColorConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) HexColorConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c HexColorConstraint) When(condition bool) HexColorConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c HexColorConstraint) WhenGroups(groups ...string) HexColorConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c HexColorConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if predicate.HexColor(*value) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
+
+type RGBColorConstraint struct {
+	err               error
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsRGBColor() RGBColorConstraint {
+	return RGBColorConstraint{
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c RGBColorConstraint) WithError(err error) RGBColorConstraint {
+	c.err = err
+	return c
+}
+
+func (c RGBColorConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) RGBColorConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c RGBColorConstraint) When(condition bool) RGBColorConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c RGBColorConstraint) WhenGroups(groups ...string) RGBColorConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c RGBColorConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if predicate.RGBColor(*value) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
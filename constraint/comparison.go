@@ -0,0 +1,136 @@
+package constraint
+
+import (
+	"context"
+	"fmt"
+
+	"line/validation"
+)
+
+type EqualityConstraint[T comparable] struct {
+	err               error
+	reference         T
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func EqualTo[T comparable](reference T) EqualityConstraint[T] {
+	return EqualityConstraint[T]{
+		reference:       reference,
+		err:             validation.ErrNotEqual,
+		messageTemplate: validation.ErrNotEqual.Message(),
+	}
+}
+
+func (c EqualityConstraint[T]) WithError(err error) EqualityConstraint[T] {
+	c.err = err
+	return c
+}
+
+func (c EqualityConstraint[T]) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) EqualityConstraint[T] {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c EqualityConstraint[T]) When(condition bool) EqualityConstraint[T] {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c EqualityConstraint[T]) WhenGroups(groups ...string) EqualityConstraint[T] {
+	c.groups = groups
+	return c
+}
+
+func (c EqualityConstraint[T]) ValidateComparable(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *T,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil ||
+		*value == c.reference {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", fmt.Sprint(*value)),
+				validation.StringParam("{{ reference }}", fmt.Sprint(c.reference)),
+				validation.StringParam("{{ comparedValue }}", fmt.Sprint(c.reference)),
+			)...,
+		).
+		Create()
+}
+
+type InequalityConstraint[T comparable] struct {
+	err               error
+	reference         T
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func NotEqualTo[T comparable](reference T) InequalityConstraint[T] {
+	return InequalityConstraint[T]{
+		reference:       reference,
+		err:             validation.ErrIsEqual,
+		messageTemplate: validation.ErrIsEqual.Message(),
+	}
+}
+
+func (c InequalityConstraint[T]) WithError(err error) InequalityConstraint[T] {
+	c.err = err
+	return c
+}
+
+func (c InequalityConstraint[T]) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) InequalityConstraint[T] {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c InequalityConstraint[T]) When(condition bool) InequalityConstraint[T] {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c InequalityConstraint[T]) WhenGroups(groups ...string) InequalityConstraint[T] {
+	c.groups = groups
+	return c
+}
+
+func (c InequalityConstraint[T]) ValidateComparable(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *T,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil ||
+		*value != c.reference {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", fmt.Sprint(*value)),
+				validation.StringParam("{{ reference }}", fmt.Sprint(c.reference)),
+				validation.StringParam("{{ comparedValue }}", fmt.Sprint(c.reference)),
+			)...,
+		).
+		Create()
+}
@@ -2,17 +2,21 @@ package constraint
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
+	"line/predicate"
 	"line/validation"
 )
 
 type DateTimeConstraint struct {
 	err               error
+	location          *time.Location
 	layout            string
 	messageTemplate   string
 	groups            []string
 	messageParameters validation.TemplateParameterList
+	onSuccess         func(time.Time)
 	isIgnored         bool
 }
 
@@ -45,6 +49,11 @@ func (c DateTimeConstraint) WithLayout(layout string) DateTimeConstraint {
 	return c
 }
 
+func (c DateTimeConstraint) WithLocation(location *time.Location) DateTimeConstraint {
+	c.location = location
+	return c
+}
+
 func (c DateTimeConstraint) WithError(err error) DateTimeConstraint {
 	c.err = err
 	return c
@@ -70,6 +79,11 @@ func (c DateTimeConstraint) WhenGroups(groups ...string) DateTimeConstraint {
 	return c
 }
 
+func (c DateTimeConstraint) OnSuccess(fn func(time.Time)) DateTimeConstraint {
+	c.onSuccess = fn
+	return c
+}
+
 func (c DateTimeConstraint) ValidateString(
 	ctx context.Context,
 	validator *validation.Validator,
@@ -79,7 +93,11 @@ func (c DateTimeConstraint) ValidateString(
 		return nil
 	}
 
-	if _, err := time.Parse(c.layout, *value); err == nil {
+	if parsed, err := c.parse(*value); err == nil {
+		if c.onSuccess != nil {
+			c.onSuccess(parsed)
+		}
+
 		return nil
 	}
 
@@ -92,3 +110,815 @@ func (c DateTimeConstraint) ValidateString(
 		).
 		WithParameter("{{ value }}", *value).Create()
 }
+
+func (c DateTimeConstraint) parse(value string) (time.Time, error) {
+	if c.location != nil {
+		return time.ParseInLocation(c.layout, value, c.location)
+	}
+
+	return time.Parse(c.layout, value)
+}
+
+func (c DateTimeConstraint) ValidateComparable(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *time.Time,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil ||
+		value.IsZero() {
+		return nil
+	}
+
+	formatted := value.Format(c.layout)
+	if parsed, err := c.parse(formatted); err == nil && parsed.Equal(*value) {
+		return nil
+	}
+
+	return validator.BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.TemplateParameter{Key: "{{ layout }}", Value: c.layout},
+				validation.TemplateParameter{Key: "{{ value }}", Value: formatted},
+			)...,
+		).
+		Create()
+}
+
+type ParsedDateTimeConstraint struct {
+	value      *atomic.Pointer[time.Time]
+	constraint DateTimeConstraint
+}
+
+func (c DateTimeConstraint) Parsed() ParsedDateTimeConstraint {
+	return ParsedDateTimeConstraint{
+		value:      &atomic.Pointer[time.Time]{},
+		constraint: c,
+	}
+}
+
+func (c ParsedDateTimeConstraint) WithLayout(layout string) ParsedDateTimeConstraint {
+	c.constraint = c.constraint.WithLayout(layout)
+	return c
+}
+
+func (c ParsedDateTimeConstraint) WithLocation(location *time.Location) ParsedDateTimeConstraint {
+	c.constraint = c.constraint.WithLocation(location)
+	return c
+}
+
+func (c ParsedDateTimeConstraint) WithError(err error) ParsedDateTimeConstraint {
+	c.constraint = c.constraint.WithError(err)
+	return c
+}
+
+func (c ParsedDateTimeConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) ParsedDateTimeConstraint {
+	c.constraint = c.constraint.WithMessage(template, parameters...)
+
+	return c
+}
+
+func (c ParsedDateTimeConstraint) When(condition bool) ParsedDateTimeConstraint {
+	c.constraint = c.constraint.When(condition)
+	return c
+}
+
+func (c ParsedDateTimeConstraint) WhenGroups(groups ...string) ParsedDateTimeConstraint {
+	c.constraint = c.constraint.WhenGroups(groups...)
+	return c
+}
+
+func (c ParsedDateTimeConstraint) OnSuccess(fn func(time.Time)) ParsedDateTimeConstraint {
+	c.constraint = c.constraint.OnSuccess(fn)
+	return c
+}
+
+func (c ParsedDateTimeConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	constraint := c.constraint
+	onSuccess := constraint.onSuccess
+	constraint.onSuccess = func(t time.Time) {
+		c.value.Store(&t)
+
+		if onSuccess != nil {
+			onSuccess(t)
+		}
+	}
+
+	return constraint.ValidateString(ctx, validator, value)
+}
+
+func (c ParsedDateTimeConstraint) ValidateComparable(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *time.Time,
+) error {
+	return c.constraint.ValidateComparable(ctx, validator, value)
+}
+
+func (c ParsedDateTimeConstraint) ParsedValue() time.Time {
+	if t := c.value.Load(); t != nil {
+		return *t
+	}
+
+	return time.Time{}
+}
+
+type timeComparison byte
+
+const (
+	afterComparison timeComparison = iota
+	afterOrEqualComparison
+	beforeComparison
+	beforeOrEqualComparison
+)
+
+type TimeComparisonConstraint struct {
+	err               error
+	reference         time.Time
+	location          *time.Location
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	comparison        timeComparison
+	isIgnored         bool
+}
+
+func newTimeComparison(
+	reference time.Time,
+	comparison timeComparison,
+	err *validation.Error,
+) TimeComparisonConstraint {
+	return TimeComparisonConstraint{
+		reference:       reference,
+		comparison:      comparison,
+		err:             err,
+		messageTemplate: err.Message(),
+	}
+}
+
+func IsAfter(reference time.Time) TimeComparisonConstraint {
+	return newTimeComparison(reference, afterComparison, validation.ErrTooEarly)
+}
+
+func IsAfterOrEqual(reference time.Time) TimeComparisonConstraint {
+	return newTimeComparison(reference, afterOrEqualComparison, validation.ErrTooEarlyOrEqual)
+}
+
+func IsBefore(reference time.Time) TimeComparisonConstraint {
+	return newTimeComparison(reference, beforeComparison, validation.ErrTooLate)
+}
+
+func IsBeforeOrEqual(reference time.Time) TimeComparisonConstraint {
+	return newTimeComparison(reference, beforeOrEqualComparison, validation.ErrTooLateOrEqual)
+}
+
+func (c TimeComparisonConstraint) WithLocation(location *time.Location) TimeComparisonConstraint {
+	c.location = location
+	return c
+}
+
+func (c TimeComparisonConstraint) WithError(err error) TimeComparisonConstraint {
+	c.err = err
+	return c
+}
+
+func (c TimeComparisonConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) TimeComparisonConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c TimeComparisonConstraint) When(condition bool) TimeComparisonConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c TimeComparisonConstraint) WhenGroups(groups ...string) TimeComparisonConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c TimeComparisonConstraint) ValidateTime(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *time.Time,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil ||
+		value.IsZero() {
+		return nil
+	}
+
+	t, reference := *value, c.reference
+	if c.location != nil {
+		t, reference = t.In(c.location), reference.In(c.location)
+	}
+
+	if c.isValid(t, reference) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.TimeParam("{{ value }}", t, time.RFC3339),
+				validation.TimeParam("{{ limit }}", reference, time.RFC3339),
+				validation.TimeParam("{{ comparedValue }}", reference, time.RFC3339),
+			)...,
+		).
+		Create()
+}
+
+func (c TimeComparisonConstraint) isValid(value, reference time.Time) bool {
+	switch c.comparison {
+	case afterComparison:
+		return value.After(reference)
+	case afterOrEqualComparison:
+		return !value.Before(reference)
+	case beforeComparison:
+		return value.Before(reference)
+	case beforeOrEqualComparison:
+		return !value.After(reference)
+	}
+
+	return false
+}
+
+type FutureConstraint struct {
+	err               error
+	now               func() time.Time
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsInFuture() FutureConstraint {
+	return FutureConstraint{
+		now:             time.Now,
+		err:             validation.ErrTooEarly,
+		messageTemplate: validation.ErrTooEarly.Message(),
+	}
+}
+
+func (c FutureConstraint) WithNowFunc(now func() time.Time) FutureConstraint {
+	c.now = now
+	return c
+}
+
+func (c FutureConstraint) WithError(err error) FutureConstraint {
+	c.err = err
+	return c
+}
+
+func (c FutureConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) FutureConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c FutureConstraint) When(condition bool) FutureConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c FutureConstraint) WhenGroups(groups ...string) FutureConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c FutureConstraint) ValidateTime(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *time.Time,
+) error {
+	if c.now == nil {
+		return validator.CreateConstraintError("FutureConstraint", "nil now func")
+	}
+
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil ||
+		value.IsZero() {
+		return nil
+	}
+
+	now := c.now()
+	if value.After(now) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.TimeParam("{{ value }}", *value, time.RFC3339),
+				validation.TimeParam("{{ comparedValue }}", now, time.RFC3339),
+			)...,
+		).
+		Create()
+}
+
+type PastConstraint struct {
+	err               error
+	now               func() time.Time
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsInPast() PastConstraint {
+	return PastConstraint{
+		now:             time.Now,
+		err:             validation.ErrTooLate,
+		messageTemplate: validation.ErrTooLate.Message(),
+	}
+}
+
+func (c PastConstraint) WithNowFunc(now func() time.Time) PastConstraint {
+	c.now = now
+	return c
+}
+
+func (c PastConstraint) WithError(err error) PastConstraint {
+	c.err = err
+	return c
+}
+
+func (c PastConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) PastConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c PastConstraint) When(condition bool) PastConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c PastConstraint) WhenGroups(groups ...string) PastConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c PastConstraint) ValidateTime(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *time.Time,
+) error {
+	if c.now == nil {
+		return validator.CreateConstraintError("PastConstraint", "nil now func")
+	}
+
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil ||
+		value.IsZero() {
+		return nil
+	}
+
+	now := c.now()
+	if value.Before(now) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.TimeParam("{{ value }}", *value, time.RFC3339),
+				validation.TimeParam("{{ comparedValue }}", now, time.RFC3339),
+			)...,
+		).
+		Create()
+}
+
+type WeekdayConstraint struct {
+	err               error
+	location          *time.Location
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsWeekday() WeekdayConstraint {
+	return WeekdayConstraint{
+		err:             validation.ErrNotWeekday,
+		messageTemplate: validation.ErrNotWeekday.Message(),
+	}
+}
+
+func (c WeekdayConstraint) WithLocation(location *time.Location) WeekdayConstraint {
+	c.location = location
+	return c
+}
+
+func (c WeekdayConstraint) WithError(err error) WeekdayConstraint {
+	c.err = err
+	return c
+}
+
+func (c WeekdayConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) WeekdayConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c WeekdayConstraint) When(condition bool) WeekdayConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c WeekdayConstraint) WhenGroups(groups ...string) WeekdayConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c WeekdayConstraint) ValidateTime(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *time.Time,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil ||
+		value.IsZero() {
+		return nil
+	}
+
+	weekday := weekdayIn(*value, c.location)
+	if !isWeekend(weekday) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.TimeParam("{{ value }}", *value, time.RFC3339),
+				validation.StringParam("{{ weekday }}", weekday.String()),
+			)...,
+		).
+		Create()
+}
+
+type WeekendConstraint struct {
+	err               error
+	location          *time.Location
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsWeekend() WeekendConstraint {
+	return WeekendConstraint{
+		err:             validation.ErrNotWeekend,
+		messageTemplate: validation.ErrNotWeekend.Message(),
+	}
+}
+
+func (c WeekendConstraint) WithLocation(location *time.Location) WeekendConstraint {
+	c.location = location
+	return c
+}
+
+func (c WeekendConstraint) WithError(err error) WeekendConstraint {
+	c.err = err
+	return c
+}
+
+func (c WeekendConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) WeekendConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c WeekendConstraint) When(condition bool) WeekendConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c WeekendConstraint) WhenGroups(groups ...string) WeekendConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c WeekendConstraint) ValidateTime(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *time.Time,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil ||
+		value.IsZero() {
+		return nil
+	}
+
+	weekday := weekdayIn(*value, c.location)
+	if isWeekend(weekday) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.TimeParam("{{ value }}", *value, time.RFC3339),
+				validation.StringParam("{{ weekday }}", weekday.String()),
+			)...,
+		).
+		Create()
+}
+
+func weekdayIn(value time.Time, location *time.Location) time.Weekday {
+	if location != nil {
+		value = value.In(location)
+	}
+
+	return value.Weekday()
+}
+
+func isWeekend(weekday time.Weekday) bool {
+	return weekday == time.Saturday || weekday == time.Sunday
+}
+
+type TimeBetweenConstraint struct {
+	minErr               error
+	maxErr               error
+	min                  time.Time
+	max                  time.Time
+	minMessageTemplate   string
+	maxMessageTemplate   string
+	groups               []string
+	minMessageParameters validation.TemplateParameterList
+	maxMessageParameters validation.TemplateParameterList
+	isExclusiveMin       bool
+	isExclusiveMax       bool
+	isIgnored            bool
+}
+
+func IsTimeBetween(min, max time.Time) TimeBetweenConstraint {
+	return TimeBetweenConstraint{
+		min:                min,
+		max:                max,
+		minErr:             validation.ErrTooEarly,
+		maxErr:             validation.ErrTooLate,
+		minMessageTemplate: validation.ErrTooEarly.Message(),
+		maxMessageTemplate: validation.ErrTooLate.Message(),
+	}
+}
+
+func (c TimeBetweenConstraint) WithExclusiveMin() TimeBetweenConstraint {
+	c.isExclusiveMin = true
+	return c
+}
+
+func (c TimeBetweenConstraint) WithExclusiveMax() TimeBetweenConstraint {
+	c.isExclusiveMax = true
+	return c
+}
+
+func (c TimeBetweenConstraint) WithMinError(err error) TimeBetweenConstraint {
+	c.minErr = err
+	return c
+}
+
+func (c TimeBetweenConstraint) WithMaxError(err error) TimeBetweenConstraint {
+	c.maxErr = err
+	return c
+}
+
+func (c TimeBetweenConstraint) WithMinMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) TimeBetweenConstraint {
+	c.minMessageTemplate = template
+	c.minMessageParameters = parameters
+
+	return c
+}
+
+func (c TimeBetweenConstraint) WithMaxMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) TimeBetweenConstraint {
+	c.maxMessageTemplate = template
+	c.maxMessageParameters = parameters
+
+	return c
+}
+
+func (c TimeBetweenConstraint) When(condition bool) TimeBetweenConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c TimeBetweenConstraint) WhenGroups(groups ...string) TimeBetweenConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c TimeBetweenConstraint) ValidateTime(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *time.Time,
+) error {
+	if c.min.After(c.max) {
+		return validator.CreateConstraintError(
+			"TimeBetweenConstraint",
+			"min must not be after max",
+		)
+	}
+
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil ||
+		value.IsZero() {
+		return nil
+	}
+
+	if value.Before(c.min) || c.isExclusiveMin && value.Equal(c.min) {
+		return c.newViolation(
+			ctx,
+			validator,
+			*value,
+			c.min,
+			c.minErr,
+			c.minMessageTemplate,
+			c.minMessageParameters,
+		)
+	}
+
+	if value.After(c.max) || c.isExclusiveMax && value.Equal(c.max) {
+		return c.newViolation(
+			ctx,
+			validator,
+			*value,
+			c.max,
+			c.maxErr,
+			c.maxMessageTemplate,
+			c.maxMessageParameters,
+		)
+	}
+
+	return nil
+}
+
+func (c TimeBetweenConstraint) newViolation(
+	ctx context.Context,
+	validator *validation.Validator,
+	value, limit time.Time,
+	err error,
+	template string,
+	parameters validation.TemplateParameterList,
+) validation.Violation {
+	return validator.
+		BuildViolation(ctx, err, template).
+		WithParameters(
+			parameters.Prepend(
+				validation.TimeParam("{{ value }}", value, time.RFC3339),
+				validation.TimeParam("{{ min }}", c.min, time.RFC3339),
+				validation.TimeParam("{{ max }}", c.max, time.RFC3339),
+				validation.TimeParam("{{ comparedValue }}", limit, time.RFC3339),
+			)...,
+		).
+		Create()
+}
+
+type TimeZoneConstraint struct {
+	err               error
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	allowUTC          bool
+	allowLocal        bool
+	isIgnored         bool
+}
+
+func IsTimeZone() TimeZoneConstraint {
+	return TimeZoneConstraint{
+		allowUTC:        true,
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c TimeZoneConstraint) WithAllowUTC(allow bool) TimeZoneConstraint {
+	c.allowUTC = allow
+	return c
+}
+
+func (c TimeZoneConstraint) WithAllowLocal(allow bool) TimeZoneConstraint {
+	c.allowLocal = allow
+	return c
+}
+
+func (c TimeZoneConstraint) WithError(err error) TimeZoneConstraint {
+	c.err = err
+	return c
+}
+
+func (c TimeZoneConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) TimeZoneConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c TimeZoneConstraint) When(condition bool) TimeZoneConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c TimeZoneConstraint) WhenGroups(groups ...string) TimeZoneConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c TimeZoneConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if c.isValid(*value) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
+
+func (c TimeZoneConstraint) isValid(value string) bool {
+	if !predicate.TimeZone(value) {
+		return false
+	}
+
+	location, err := time.LoadLocation(value)
+	if err != nil {
+		return false
+	}
+
+	switch {
+	case location == time.Local:
+		return c.allowLocal
+	case isUTCLocation(location):
+		return c.allowUTC
+	default:
+		return true
+	}
+}
+
+var utcProbeTimes = []time.Time{
+	time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1970, time.July, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(2000, time.July, 1, 0, 0, 0, 0, time.UTC),
+}
+
+func isUTCLocation(location *time.Location) bool {
+	if location == time.UTC {
+		return true
+	}
+
+	for _, t := range utcProbeTimes {
+		if name, offset := t.In(location).Zone(); name != "UTC" || offset != 0 {
+			return false
+		}
+	}
+
+	return true
+}
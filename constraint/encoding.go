@@ -0,0 +1,336 @@
+package constraint
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"errors"
+	"io"
+	"mime"
+	"slices"
+	"strings"
+
+	"line/validation"
+)
+
+type Base64Constraint struct {
+	err               error
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isURLEncoding     bool
+	isNoPadding       bool
+	isIgnored         bool
+}
+
+func IsBase64() Base64Constraint {
+	return Base64Constraint{
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c Base64Constraint) WithURLEncoding() Base64Constraint {
+	c.isURLEncoding = true
+	return c
+}
+
+func (c Base64Constraint) WithNoPadding() Base64Constraint {
+	c.isNoPadding = true
+	return c
+}
+
+func (c Base64Constraint) WithError(err error) Base64Constraint {
+	c.err = err
+	return c
+}
+
+func (c Base64Constraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) Base64Constraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c Base64Constraint) When(condition bool) Base64Constraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c Base64Constraint) WhenGroups(groups ...string) Base64Constraint {
+	c.groups = groups
+	return c
+}
+
+func (c Base64Constraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if c.isValid(*value) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
+
+func (c Base64Constraint) isValid(value string) bool {
+	_, err := c.encoding().DecodeString(value)
+	return err == nil
+}
+
+func (c Base64Constraint) encoding() *base64.Encoding {
+	switch {
+	case c.isURLEncoding && c.isNoPadding:
+		return base64.RawURLEncoding
+	case c.isURLEncoding:
+		return base64.URLEncoding
+	case c.isNoPadding:
+		return base64.RawStdEncoding
+	default:
+		return base64.StdEncoding
+	}
+}
+
+type MIMEConstraint struct {
+	err                     error
+	choiceErr               error
+	messageTemplate         string
+	choiceMessageTemplate   string
+	allowedTypes            []string
+	groups                  []string
+	messageParameters       validation.TemplateParameterList
+	choiceMessageParameters validation.TemplateParameterList
+	isIgnored               bool
+}
+
+func IsMIMEType() MIMEConstraint {
+	return MIMEConstraint{
+		err:                   validation.ErrNotValid,
+		choiceErr:             validation.ErrNoSuchChoice,
+		messageTemplate:       validation.ErrNotValid.Message(),
+		choiceMessageTemplate: validation.ErrNoSuchChoice.Message(),
+	}
+}
+
+func (c MIMEConstraint) WithAllowedTypes(types ...string) MIMEConstraint {
+	c.allowedTypes = types
+	return c
+}
+
+func (c MIMEConstraint) WithError(err error) MIMEConstraint {
+	c.err = err
+	return c
+}
+
+func (c MIMEConstraint) WithChoiceError(err error) MIMEConstraint {
+	c.choiceErr = err
+	return c
+}
+
+func (c MIMEConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) MIMEConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c MIMEConstraint) WithChoiceMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) MIMEConstraint {
+	c.choiceMessageTemplate = template
+	c.choiceMessageParameters = parameters
+
+	return c
+}
+
+func (c MIMEConstraint) When(condition bool) MIMEConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c MIMEConstraint) WhenGroups(groups ...string) MIMEConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c MIMEConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(*value)
+	if err != nil {
+		return validator.
+			BuildViolation(ctx, c.err, c.messageTemplate).
+			WithParameters(
+				c.messageParameters.Prepend(
+					validation.StringParam("{{ value }}", *value),
+				)...,
+			).
+			Create()
+	}
+
+	if len(c.allowedTypes) == 0 ||
+		slices.ContainsFunc(c.allowedTypes, func(t string) bool {
+			return strings.EqualFold(t, mediaType)
+		}) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.choiceErr, c.choiceMessageTemplate).
+		WithParameters(
+			c.choiceMessageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+				validation.StringParam("{{ choices }}", strings.Join(c.allowedTypes, ", ")),
+			)...,
+		).
+		Create()
+}
+
+type CSVConstraint struct {
+	err                         error
+	fieldCountErr               error
+	messageTemplate             string
+	fieldCountMessageTemplate   string
+	groups                      []string
+	messageParameters           validation.TemplateParameterList
+	fieldCountMessageParameters validation.TemplateParameterList
+	expectedFieldCount          int
+	comma                       rune
+	lazyQuotes                  bool
+	isIgnored                   bool
+}
+
+func IsCSV() CSVConstraint {
+	return CSVConstraint{
+		comma:                     ',',
+		err:                       validation.ErrNotValid,
+		fieldCountErr:             validation.ErrWrongFieldCount,
+		messageTemplate:           validation.ErrNotValid.Message(),
+		fieldCountMessageTemplate: validation.ErrWrongFieldCount.Message(),
+	}
+}
+
+func (c CSVConstraint) WithComma(comma rune) CSVConstraint {
+	c.comma = comma
+	return c
+}
+
+func (c CSVConstraint) WithLazyQuotes(lazyQuotes bool) CSVConstraint {
+	c.lazyQuotes = lazyQuotes
+	return c
+}
+
+func (c CSVConstraint) WithExpectedFieldCount(n int) CSVConstraint {
+	c.expectedFieldCount = n
+	return c
+}
+
+func (c CSVConstraint) WithError(err error) CSVConstraint {
+	c.err = err
+	return c
+}
+
+func (c CSVConstraint) WithFieldCountError(err error) CSVConstraint {
+	c.fieldCountErr = err
+	return c
+}
+
+func (c CSVConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) CSVConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c CSVConstraint) WithFieldCountMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) CSVConstraint {
+	c.fieldCountMessageTemplate = template
+	c.fieldCountMessageParameters = parameters
+
+	return c
+}
+
+func (c CSVConstraint) When(condition bool) CSVConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c CSVConstraint) WhenGroups(groups ...string) CSVConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c CSVConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	reader := csv.NewReader(strings.NewReader(*value))
+	reader.Comma = c.comma
+	reader.LazyQuotes = c.lazyQuotes
+	reader.FieldsPerRecord = -1
+
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return validator.
+				BuildViolation(ctx, c.err, c.messageTemplate).
+				WithParameters(
+					c.messageParameters.Prepend(
+						validation.StringParam("{{ value }}", *value),
+					)...,
+				).
+				Create()
+		}
+
+		if c.expectedFieldCount > 0 && len(record) != c.expectedFieldCount {
+			return validator.
+				BuildViolation(ctx, c.fieldCountErr, c.fieldCountMessageTemplate).
+				WithParameters(
+					c.fieldCountMessageParameters.Prepend(
+						validation.IntParam("{{ row }}", row),
+						validation.IntParam("{{ count }}", len(record)),
+						validation.IntParam("{{ limit }}", c.expectedFieldCount),
+					)...,
+				).
+				Create()
+		}
+	}
+}
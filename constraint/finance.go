@@ -0,0 +1,231 @@
+package constraint
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"line/predicate"
+	"line/validation"
+)
+
+type IBANConstraint struct {
+	err               error
+	messageTemplate   string
+	countries         []string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsIBAN() IBANConstraint {
+	return IBANConstraint{
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c IBANConstraint) WithAllowedCountries(codes ...string) IBANConstraint {
+	c.countries = codes
+	return c
+}
+
+func (c IBANConstraint) WithError(err error) IBANConstraint {
+	c.err = err
+	return c
+}
+
+func (c IBANConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) IBANConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c IBANConstraint) When(condition bool) IBANConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c IBANConstraint) WhenGroups(groups ...string) IBANConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c IBANConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if c.isValid(*value) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
+
+func (c IBANConstraint) isValid(value string) bool {
+	if !predicate.IBAN(value) {
+		return false
+	}
+
+	country := predicate.IBANCountry(value)
+
+	return len(c.countries) == 0 || slices.ContainsFunc(c.countries, func(code string) bool {
+		return strings.EqualFold(code, country)
+	})
+}
+
+type LuhnConstraint struct {
+	err               error
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsLuhn() LuhnConstraint {
+	return LuhnConstraint{
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c LuhnConstraint) WithError(err error) LuhnConstraint {
+	c.err = err
+	return c
+}
+
+func (c LuhnConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) LuhnConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c LuhnConstraint) When(condition bool) LuhnConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c LuhnConstraint) WhenGroups(groups ...string) LuhnConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c LuhnConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if predicate.Luhn(*value) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
+
+type CurrencyConstraint struct {
+	err               error
+	customCodes       map[string]bool
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsCurrencyCode() CurrencyConstraint {
+	return CurrencyConstraint{
+		err:             validation.ErrNoSuchChoice,
+		messageTemplate: validation.ErrNoSuchChoice.Message(),
+	}
+}
+
+func (c CurrencyConstraint) WithCustomCodes(codes ...string) CurrencyConstraint {
+	customCodes := make(map[string]bool, len(c.customCodes)+len(codes))
+	maps.Copy(customCodes, c.customCodes)
+	for _, code := range codes {
+		customCodes[code] = true
+	}
+	c.customCodes = customCodes
+
+	return c
+}
+
+func (c CurrencyConstraint) WithError(err error) CurrencyConstraint {
+	c.err = err
+	return c
+}
+
+func (c CurrencyConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) CurrencyConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c CurrencyConstraint) When(condition bool) CurrencyConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c CurrencyConstraint) WhenGroups(groups ...string) CurrencyConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c CurrencyConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if predicate.CurrencyCode(*value) || c.customCodes[*value] {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+				validation.StringParam("{{ choices }}", "ISO 4217 alphabetic code"),
+			)...,
+		).
+		Create()
+}
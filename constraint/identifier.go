@@ -0,0 +1,241 @@
+package constraint
+
+import (
+	"context"
+	"strings"
+
+	"line/predicate"
+	"line/validation"
+)
+
+const (
+	minUUIDVersion = 1
+	maxUUIDVersion = 5
+)
+
+type UUIDConstraint struct {
+	err               error
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	version           int
+	isIgnored         bool
+}
+
+func IsUUID() UUIDConstraint {
+	return UUIDConstraint{
+		err:             validation.ErrInvalidUUID,
+		messageTemplate: validation.ErrInvalidUUID.Message(),
+	}
+}
+
+func IsUUIDVersion(version int) UUIDConstraint {
+	c := IsUUID()
+	c.version = version
+
+	return c
+}
+
+func (c UUIDConstraint) WithError(err error) UUIDConstraint {
+	c.err = err
+	return c
+}
+
+func (c UUIDConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) UUIDConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c UUIDConstraint) When(condition bool) UUIDConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c UUIDConstraint) WhenGroups(groups ...string) UUIDConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c UUIDConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.version != 0 && (c.version < minUUIDVersion || c.version > maxUUIDVersion) {
+		return validator.CreateConstraintError("UUIDConstraint", "version must be between 1 and 5")
+	}
+
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if c.version == 0 && predicate.UUID(*value) ||
+		c.version != 0 && predicate.UUIDVersion(*value, c.version) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
+
+type SemVerConstraint struct {
+	err                  error
+	messageTemplate      string
+	groups               []string
+	messageParameters    validation.TemplateParameterList
+	requireBuildMetadata bool
+	requirePreRelease    bool
+	isIgnored            bool
+}
+
+func IsSemVer() SemVerConstraint {
+	return SemVerConstraint{
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c SemVerConstraint) WithRequireBuildMetadata() SemVerConstraint {
+	c.requireBuildMetadata = true
+	return c
+}
+
+func (c SemVerConstraint) WithRequirePreRelease() SemVerConstraint {
+	c.requirePreRelease = true
+	return c
+}
+
+func (c SemVerConstraint) WithError(err error) SemVerConstraint {
+	c.err = err
+	return c
+}
+
+func (c SemVerConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) SemVerConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c SemVerConstraint) When(condition bool) SemVerConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c SemVerConstraint) WhenGroups(groups ...string) SemVerConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c SemVerConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if c.isValid(*value) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
+
+func (c SemVerConstraint) isValid(value string) bool {
+	if !predicate.SemVer(value) {
+		return false
+	}
+
+	version, _, hasBuild := strings.Cut(value, "+")
+	if c.requireBuildMetadata && !hasBuild {
+		return false
+	}
+
+	return !c.requirePreRelease || strings.Contains(version, "-")
+}
+
+type ULIDConstraint struct {
+	err               error
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsULID() ULIDConstraint {
+	return ULIDConstraint{
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c ULIDConstraint) WithError(err error) ULIDConstraint {
+	c.err = err
+	return c
+}
+
+func (c ULIDConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) ULIDConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c ULIDConstraint) When(condition bool) ULIDConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c ULIDConstraint) WhenGroups(groups ...string) ULIDConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c ULIDConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if predicate.ULID(*value) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
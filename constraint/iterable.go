@@ -1,7 +1,10 @@
 package constraint
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"math"
 	"strconv"
 
 	"line/validation"
@@ -127,6 +130,20 @@ func (c CountConstraint) WithMaxMessage(
 	return c
 }
 
+func (c CountConstraint) WithTooFewMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) CountConstraint {
+	return c.WithMinMessage(template, parameters...)
+}
+
+func (c CountConstraint) WithTooManyMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) CountConstraint {
+	return c.WithMaxMessage(template, parameters...)
+}
+
 func (c CountConstraint) WithExactMessage(
 	template string,
 	parameters ...validation.TemplateParameter,
@@ -147,6 +164,22 @@ func (c CountConstraint) WithDivisibleMessage(
 	return c
 }
 
+func (c CountConstraint) Min() int {
+	return c.min
+}
+
+func (c CountConstraint) Max() int {
+	return c.max
+}
+
+func (c CountConstraint) HasMin() bool {
+	return c.checkMin
+}
+
+func (c CountConstraint) HasMax() bool {
+	return c.checkMax
+}
+
 func (c CountConstraint) ValidateCountable(
 	ctx context.Context,
 	validator *validation.Validator,
@@ -165,7 +198,7 @@ func (c CountConstraint) ValidateCountable(
 		}
 
 		if count%c.divisibleBy != 0 {
-			return c.newNotDivisibleViolation(ctx, validator, count)
+			return c.newNotDivisibleViolation(ctx, validator, strconv.Itoa(count))
 		}
 	}
 
@@ -173,7 +206,7 @@ func (c CountConstraint) ValidateCountable(
 		return c.newViolation(
 			ctx,
 			validator,
-			count,
+			strconv.Itoa(count),
 			c.max,
 			c.maxErr,
 			c.maxMessageTemplate,
@@ -185,7 +218,7 @@ func (c CountConstraint) ValidateCountable(
 		return c.newViolation(
 			ctx,
 			validator,
-			count,
+			strconv.Itoa(count),
 			c.min,
 			c.minErr,
 			c.minMessageTemplate,
@@ -196,10 +229,52 @@ func (c CountConstraint) ValidateCountable(
 	return nil
 }
 
+func (c CountConstraint) ValidateUint64Countable(
+	ctx context.Context,
+	validator *validation.Validator,
+	count uint64,
+) error {
+	if count <= math.MaxInt {
+		return c.ValidateCountable(ctx, validator, int(count))
+	}
+
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) {
+		return nil
+	}
+
+	if c.checkDivisible {
+		if c.divisibleBy <= 0 {
+			return validator.CreateConstraintError(
+				"CountConstraint",
+				"divisibleBy must be greater than zero",
+			)
+		}
+
+		if count%uint64(c.divisibleBy) != 0 {
+			return c.newNotDivisibleViolation(ctx, validator, strconv.FormatUint(count, 10))
+		}
+	}
+
+	if c.checkMax {
+		return c.newViolation(
+			ctx,
+			validator,
+			strconv.FormatUint(count, 10),
+			c.max,
+			c.maxErr,
+			c.maxMessageTemplate,
+			c.maxMessageParameters,
+		)
+	}
+
+	return nil
+}
+
 func (c CountConstraint) newViolation(
 	ctx context.Context,
 	validator *validation.Validator,
-	count, limit int,
+	count string,
+	limit int,
 	err error,
 	template string,
 	parameters validation.TemplateParameterList,
@@ -213,8 +288,8 @@ func (c CountConstraint) newViolation(
 	return validator.BuildViolation(ctx, err, template).
 		WithParameters(
 			parameters.Prepend(
-				validation.TemplateParameter{Key: "{{ count }}", Value: strconv.Itoa(count)},
-				validation.TemplateParameter{Key: "{{ limit }}", Value: strconv.Itoa(limit)},
+				validation.StringParam("{{ count }}", count),
+				validation.IntParam("{{ limit }}", limit),
 			)...,
 		).
 		Create()
@@ -223,17 +298,178 @@ func (c CountConstraint) newViolation(
 func (c CountConstraint) newNotDivisibleViolation(
 	ctx context.Context,
 	validator *validation.Validator,
-	count int,
+	count string,
 ) validation.Violation {
 	return validator.BuildViolation(ctx, c.divisibleErr, c.divisibleByMessageTemplate).
 		WithParameters(
 			c.divisibleByMessageParameters.Prepend(
-				validation.TemplateParameter{Key: "{{ count }}", Value: strconv.Itoa(count)},
-				validation.TemplateParameter{
-					Key:   "{{ divisibleBy }}",
-					Value: strconv.Itoa(c.divisibleBy),
-				},
+				validation.StringParam("{{ count }}", count),
+				validation.IntParam("{{ divisibleBy }}", c.divisibleBy),
 			)...,
 		).
 		Create()
 }
+
+type UniqueElementsConstraint[T comparable] struct {
+	err               error
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func HasUniqueElements[T comparable]() UniqueElementsConstraint[T] {
+	return UniqueElementsConstraint[T]{
+		err:             validation.ErrNotUnique,
+		messageTemplate: validation.ErrNotUnique.Message(),
+	}
+}
+
+func (c UniqueElementsConstraint[T]) WithError(err error) UniqueElementsConstraint[T] {
+	c.err = err
+	return c
+}
+
+func (c UniqueElementsConstraint[T]) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) UniqueElementsConstraint[T] {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c UniqueElementsConstraint[T]) When(condition bool) UniqueElementsConstraint[T] {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c UniqueElementsConstraint[T]) WhenGroups(groups ...string) UniqueElementsConstraint[T] {
+	c.groups = groups
+	return c
+}
+
+func (c UniqueElementsConstraint[T]) ValidateComparables(
+	ctx context.Context,
+	validator *validation.Validator,
+	values []T,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) {
+		return nil
+	}
+
+	seen := make(map[T]struct{}, len(values))
+
+	for _, value := range values {
+		if _, ok := seen[value]; !ok {
+			seen[value] = struct{}{}
+			continue
+		}
+
+		return validator.
+			BuildViolation(ctx, c.err, c.messageTemplate).
+			WithParameters(
+				c.messageParameters.Prepend(
+					validation.StringParam("{{ duplicate }}", fmt.Sprint(value)),
+				)...,
+			).
+			Create()
+	}
+
+	return nil
+}
+
+type SortedConstraint[T cmp.Ordered] struct {
+	err               error
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isDescending      bool
+	isStrict          bool
+	isIgnored         bool
+}
+
+func HasSortedElements[T cmp.Ordered]() SortedConstraint[T] {
+	return SortedConstraint[T]{
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c SortedConstraint[T]) WithDescending() SortedConstraint[T] {
+	c.isDescending = true
+	return c
+}
+
+func (c SortedConstraint[T]) WithStrict() SortedConstraint[T] {
+	c.isStrict = true
+	return c
+}
+
+func (c SortedConstraint[T]) WithError(err error) SortedConstraint[T] {
+	c.err = err
+	return c
+}
+
+func (c SortedConstraint[T]) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) SortedConstraint[T] {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c SortedConstraint[T]) When(condition bool) SortedConstraint[T] {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c SortedConstraint[T]) WhenGroups(groups ...string) SortedConstraint[T] {
+	c.groups = groups
+	return c
+}
+
+func (c SortedConstraint[T]) ValidateComparables(
+	ctx context.Context,
+	validator *validation.Validator,
+	values []T,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) {
+		return nil
+	}
+
+	for i := 1; i < len(values); i++ {
+		if c.isOrdered(values[i-1], values[i]) {
+			continue
+		}
+
+		return validator.
+			BuildViolation(ctx, c.err, c.messageTemplate).
+			WithParameters(
+				c.messageParameters.Prepend(
+					validation.IntParam("{{ index }}", i),
+					validation.StringParam("{{ a }}", fmt.Sprint(values[i-1])),
+					validation.StringParam("{{ b }}", fmt.Sprint(values[i])),
+				)...,
+			).
+			Create()
+	}
+
+	return nil
+}
+
+func (c SortedConstraint[T]) isOrdered(a, b T) bool {
+	order := cmp.Compare(a, b)
+	if c.isDescending {
+		order = -order
+	}
+
+	if c.isStrict {
+		return order < 0
+	}
+
+	return order <= 0
+}
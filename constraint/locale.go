@@ -0,0 +1,370 @@
+package constraint
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"line/predicate"
+	"line/validation"
+)
+
+type PhoneConstraint struct {
+	err               error
+	messageTemplate   string
+	countryCode       string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	minDigits         int
+	maxDigits         int
+	isIgnored         bool
+}
+
+func IsPhoneNumber() PhoneConstraint {
+	return PhoneConstraint{
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c PhoneConstraint) WithMinDigits(n int) PhoneConstraint {
+	c.minDigits = n
+	return c
+}
+
+func (c PhoneConstraint) WithMaxDigits(n int) PhoneConstraint {
+	c.maxDigits = n
+	return c
+}
+
+func (c PhoneConstraint) WithCountryCode(code string) PhoneConstraint {
+	c.countryCode = code
+	return c
+}
+
+func (c PhoneConstraint) WithError(err error) PhoneConstraint {
+	c.err = err
+	return c
+}
+
+func (c PhoneConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) PhoneConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c PhoneConstraint) When(condition bool) PhoneConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c PhoneConstraint) WhenGroups(groups ...string) PhoneConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c PhoneConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if c.isValid(*value) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
+
+func (c PhoneConstraint) isValid(value string) bool {
+	if !predicate.PhoneE164(value) {
+		return false
+	}
+
+	if c.countryCode != "" && !strings.HasPrefix(value, "+"+strings.TrimPrefix(c.countryCode, "+")) {
+		return false
+	}
+
+	digits := len(value) - 1
+
+	return digits >= c.minDigits && (c.maxDigits == 0 || digits <= c.maxDigits)
+}
+
+type PostalCodeConstraint struct {
+	err               error
+	pattern           *regexp.Regexp
+	messageTemplate   string
+	locale            string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsPostalCode(locale string) PostalCodeConstraint {
+	return PostalCodeConstraint{
+		locale:          locale,
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c PostalCodeConstraint) WithCustomPattern(pattern *regexp.Regexp) PostalCodeConstraint {
+	c.pattern = pattern
+	return c
+}
+
+func (c PostalCodeConstraint) WithError(err error) PostalCodeConstraint {
+	c.err = err
+	return c
+}
+
+func (c PostalCodeConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) PostalCodeConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c PostalCodeConstraint) When(condition bool) PostalCodeConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c PostalCodeConstraint) WhenGroups(groups ...string) PostalCodeConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c PostalCodeConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	pattern := c.pattern
+	if pattern == nil {
+		var ok bool
+		if pattern, ok = predicate.PostalCodePattern(c.locale); !ok {
+			return validator.CreateConstraintError(
+				"PostalCodeConstraint",
+				fmt.Sprintf("unknown locale %q", c.locale),
+			)
+		}
+	}
+
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if pattern.MatchString(*value) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
+
+type CountryCodeConstraint struct {
+	err               error
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isAlpha3          bool
+	isIgnored         bool
+}
+
+func IsCountryCode() CountryCodeConstraint {
+	return CountryCodeConstraint{
+		err:             validation.ErrNoSuchChoice,
+		messageTemplate: validation.ErrNoSuchChoice.Message(),
+	}
+}
+
+func (c CountryCodeConstraint) WithAlpha3() CountryCodeConstraint {
+	c.isAlpha3 = true
+	return c
+}
+
+func (c CountryCodeConstraint) WithError(err error) CountryCodeConstraint {
+	c.err = err
+	return c
+}
+
+func (c CountryCodeConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) CountryCodeConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c CountryCodeConstraint) When(condition bool) CountryCodeConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c CountryCodeConstraint) WhenGroups(groups ...string) CountryCodeConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c CountryCodeConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	format := "ISO 3166-1 alpha-2"
+	isValid := predicate.CountryCode(*value)
+	if c.isAlpha3 {
+		format = "ISO 3166-1 alpha-3"
+		isValid = predicate.CountryCodeAlpha3(*value)
+	}
+
+	if isValid {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+				validation.StringParam("{{ format }}", format),
+			)...,
+		).
+		Create()
+}
+
+type LanguageTagConstraint struct {
+	err                     error
+	choiceErr               error
+	messageTemplate         string
+	choiceMessageTemplate   string
+	allowedTags             []string
+	groups                  []string
+	messageParameters       validation.TemplateParameterList
+	choiceMessageParameters validation.TemplateParameterList
+	isIgnored               bool
+}
+
+func IsLanguageTag() LanguageTagConstraint {
+	return LanguageTagConstraint{
+		err:                   validation.ErrNotValid,
+		choiceErr:             validation.ErrNoSuchChoice,
+		messageTemplate:       validation.ErrNotValid.Message(),
+		choiceMessageTemplate: validation.ErrNoSuchChoice.Message(),
+	}
+}
+
+func (c LanguageTagConstraint) WithAllowedTags(tags ...string) LanguageTagConstraint {
+	c.allowedTags = tags
+	return c
+}
+
+func (c LanguageTagConstraint) WithError(err error) LanguageTagConstraint {
+	c.err = err
+	return c
+}
+
+func (c LanguageTagConstraint) WithChoiceError(err error) LanguageTagConstraint {
+	c.choiceErr = err
+	return c
+}
+
+func (c LanguageTagConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) LanguageTagConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c LanguageTagConstraint) WithChoiceMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) LanguageTagConstraint {
+	c.choiceMessageTemplate = template
+	c.choiceMessageParameters = parameters
+
+	return c
+}
+
+func (c LanguageTagConstraint) When(condition bool) LanguageTagConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c LanguageTagConstraint) WhenGroups(groups ...string) LanguageTagConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c LanguageTagConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if !predicate.LanguageTag(*value) {
+		return validator.
+			BuildViolation(ctx, c.err, c.messageTemplate).
+			WithParameters(
+				c.messageParameters.Prepend(
+					validation.StringParam("{{ value }}", *value),
+				)...,
+			).
+			Create()
+	}
+
+	if len(c.allowedTags) == 0 ||
+		slices.ContainsFunc(c.allowedTags, func(tag string) bool {
+			return strings.EqualFold(tag, *value)
+		}) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.choiceErr, c.choiceMessageTemplate).
+		WithParameters(
+			c.choiceMessageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+				validation.StringParam("{{ choices }}", strings.Join(c.allowedTags, ", ")),
+			)...,
+		).
+		Create()
+}
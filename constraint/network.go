@@ -0,0 +1,445 @@
+package constraint
+
+import (
+	"context"
+	"net"
+	"net/mail"
+	"net/url"
+	"slices"
+	"strings"
+
+	"line/predicate"
+	"line/validation"
+)
+
+type URLConstraint struct {
+	err               error
+	messageTemplate   string
+	schemes           []string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	requireHost       bool
+	isIgnored         bool
+}
+
+func IsURL() URLConstraint {
+	return URLConstraint{
+		schemes:         []string{"http", "https"},
+		err:             validation.ErrInvalidURL,
+		messageTemplate: validation.ErrInvalidURL.Message(),
+	}
+}
+
+func (c URLConstraint) WithSchemes(schemes ...string) URLConstraint {
+	c.schemes = schemes
+	return c
+}
+
+func (c URLConstraint) WithRequireHost() URLConstraint {
+	c.requireHost = true
+	return c
+}
+
+func (c URLConstraint) WithError(err error) URLConstraint {
+	c.err = err
+	return c
+}
+
+func (c URLConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) URLConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c URLConstraint) When(condition bool) URLConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c URLConstraint) WhenGroups(groups ...string) URLConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c URLConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if len(c.schemes) == 0 {
+		return validator.CreateConstraintError("URLConstraint", "empty list of schemes")
+	}
+
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if c.isValid(*value) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
+
+func (c URLConstraint) isValid(value string) bool {
+	u, err := url.Parse(value)
+	if err != nil || !u.IsAbs() {
+		return false
+	}
+
+	if !slices.Contains(c.schemes, strings.ToLower(u.Scheme)) {
+		return false
+	}
+
+	return !c.requireHost || u.Host != ""
+}
+
+type EmailConstraint struct {
+	err               error
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	allowDisplayName  bool
+	isIgnored         bool
+}
+
+func IsEmail() EmailConstraint {
+	return EmailConstraint{
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c EmailConstraint) WithAllowDisplayName() EmailConstraint {
+	c.allowDisplayName = true
+	return c
+}
+
+func (c EmailConstraint) WithError(err error) EmailConstraint {
+	c.err = err
+	return c
+}
+
+func (c EmailConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) EmailConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c EmailConstraint) When(condition bool) EmailConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c EmailConstraint) WhenGroups(groups ...string) EmailConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c EmailConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if c.isValid(*value) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
+
+func (c EmailConstraint) isValid(value string) bool {
+	address, err := mail.ParseAddress(value)
+	if err != nil {
+		return false
+	}
+
+	return c.allowDisplayName || address.Address == value
+}
+
+type ipVersion byte
+
+const (
+	anyIPVersion ipVersion = iota
+	ipV4
+	ipV6
+)
+
+type IPConstraint struct {
+	invalidErr                  error
+	prohibitedErr               error
+	invalidMessageTemplate      string
+	prohibitedMessageTemplate   string
+	groups                      []string
+	invalidMessageParameters    validation.TemplateParameterList
+	prohibitedMessageParameters validation.TemplateParameterList
+	version                     ipVersion
+	allowLoopback               bool
+	allowPrivate                bool
+	isIgnored                   bool
+}
+
+func newIPConstraint(version ipVersion) IPConstraint {
+	return IPConstraint{
+		version:                   version,
+		allowLoopback:             true,
+		allowPrivate:              true,
+		invalidErr:                validation.ErrInvalidIP,
+		prohibitedErr:             validation.ErrProhibitedIP,
+		invalidMessageTemplate:    validation.ErrInvalidIP.Message(),
+		prohibitedMessageTemplate: validation.ErrProhibitedIP.Message(),
+	}
+}
+
+func IsIP() IPConstraint {
+	return newIPConstraint(anyIPVersion)
+}
+
+func IsIPv4() IPConstraint {
+	return newIPConstraint(ipV4)
+}
+
+func IsIPv6() IPConstraint {
+	return newIPConstraint(ipV6)
+}
+
+func (c IPConstraint) WithAllowLoopback(allow bool) IPConstraint {
+	c.allowLoopback = allow
+	return c
+}
+
+func (c IPConstraint) WithAllowPrivate(allow bool) IPConstraint {
+	c.allowPrivate = allow
+	return c
+}
+
+func (c IPConstraint) WithError(err error) IPConstraint {
+	c.invalidErr = err
+	return c
+}
+
+func (c IPConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) IPConstraint {
+	c.invalidMessageTemplate = template
+	c.invalidMessageParameters = parameters
+
+	return c
+}
+
+func (c IPConstraint) WithProhibitedError(err error) IPConstraint {
+	c.prohibitedErr = err
+	return c
+}
+
+func (c IPConstraint) WithProhibitedMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) IPConstraint {
+	c.prohibitedMessageTemplate = template
+	c.prohibitedMessageParameters = parameters
+
+	return c
+}
+
+func (c IPConstraint) When(condition bool) IPConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c IPConstraint) WhenGroups(groups ...string) IPConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c IPConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	ip := c.parse(*value)
+	if ip == nil {
+		return c.newViolation(
+			ctx,
+			validator,
+			*value,
+			c.invalidErr,
+			c.invalidMessageTemplate,
+			c.invalidMessageParameters,
+		)
+	}
+
+	if !c.allowLoopback && ip.IsLoopback() || !c.allowPrivate && ip.IsPrivate() {
+		return c.newViolation(
+			ctx,
+			validator,
+			*value,
+			c.prohibitedErr,
+			c.prohibitedMessageTemplate,
+			c.prohibitedMessageParameters,
+		)
+	}
+
+	return nil
+}
+
+func (c IPConstraint) parse(value string) net.IP {
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return nil
+	}
+
+	isV6 := strings.Contains(value, ":")
+
+	switch c.version {
+	case ipV4:
+		if isV6 {
+			return nil
+		}
+	case ipV6:
+		if !isV6 {
+			return nil
+		}
+	case anyIPVersion:
+	}
+
+	return ip
+}
+
+func (c IPConstraint) newViolation(
+	ctx context.Context,
+	validator *validation.Validator,
+	value string,
+	err error,
+	template string,
+	parameters validation.TemplateParameterList,
+) validation.Violation {
+	return validator.
+		BuildViolation(ctx, err, template).
+		WithParameters(
+			parameters.Prepend(
+				validation.StringParam("{{ value }}", value),
+			)...,
+		).
+		Create()
+}
+
+type HostnameConstraint struct {
+	err               error
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	allowWildcard     bool
+	allowTrailingDot  bool
+	isIgnored         bool
+}
+
+func IsHostname() HostnameConstraint {
+	return HostnameConstraint{
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c HostnameConstraint) WithAllowWildcard() HostnameConstraint {
+	c.allowWildcard = true
+	return c
+}
+
+func (c HostnameConstraint) WithAllowTrailingDot() HostnameConstraint {
+	c.allowTrailingDot = true
+	return c
+}
+
+func (c HostnameConstraint) WithError(err error) HostnameConstraint {
+	c.err = err
+	return c
+}
+
+func (c HostnameConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) HostnameConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c HostnameConstraint) When(condition bool) HostnameConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c HostnameConstraint) WhenGroups(groups ...string) HostnameConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c HostnameConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if c.isValid(*value) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
+
+func (c HostnameConstraint) isValid(value string) bool {
+	if c.allowTrailingDot {
+		value = strings.TrimSuffix(value, ".")
+	}
+
+	if c.allowWildcard {
+		if rest, ok := strings.CutPrefix(value, "*."); ok {
+			value = rest
+		}
+	}
+
+	return predicate.Hostname(value)
+}
@@ -0,0 +1,479 @@
+package constraint
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"line/validation"
+)
+
+type MultipleOfConstraint[T validation.Numeric] struct {
+	err               error
+	divisor           T
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsMultipleOf[T validation.Numeric](divisor T) MultipleOfConstraint[T] {
+	return MultipleOfConstraint[T]{
+		divisor:         divisor,
+		err:             validation.ErrNotDivisible,
+		messageTemplate: validation.ErrNotDivisible.Message(),
+	}
+}
+
+func (c MultipleOfConstraint[T]) WithError(err error) MultipleOfConstraint[T] {
+	c.err = err
+	return c
+}
+
+func (c MultipleOfConstraint[T]) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) MultipleOfConstraint[T] {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c MultipleOfConstraint[T]) When(condition bool) MultipleOfConstraint[T] {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c MultipleOfConstraint[T]) WhenGroups(groups ...string) MultipleOfConstraint[T] {
+	c.groups = groups
+	return c
+}
+
+func (c MultipleOfConstraint[T]) ValidateNumber(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *T,
+) error {
+	if c.divisor == 0 {
+		return validator.CreateConstraintError("MultipleOfConstraint", "divisor must not be zero")
+	}
+
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil ||
+		isMultipleOf(*value, c.divisor) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", fmt.Sprint(*value)),
+				validation.StringParam("{{ divisor }}", fmt.Sprint(c.divisor)),
+				validation.StringParam("{{ comparedValue }}", fmt.Sprint(c.divisor)),
+			)...,
+		).
+		Create()
+}
+
+func isMultipleOf[T validation.Numeric](value, divisor T) bool {
+	var one T = 1
+	if one/2 != 0 {
+		return math.Mod(float64(value), float64(divisor)) == 0
+	}
+
+	return value-value/divisor*divisor == 0
+}
+
+type sign byte
+
+const (
+	positiveSign sign = iota
+	positiveOrZeroSign
+	negativeSign
+	negativeOrZeroSign
+)
+
+type SignConstraint[T validation.Numeric] struct {
+	err               error
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	sign              sign
+	isIgnored         bool
+}
+
+func newSignConstraint[T validation.Numeric](s sign, err *validation.Error) SignConstraint[T] {
+	return SignConstraint[T]{
+		sign:            s,
+		err:             err,
+		messageTemplate: err.Message(),
+	}
+}
+
+func IsPositive[T validation.Numeric]() SignConstraint[T] {
+	return newSignConstraint[T](positiveSign, validation.ErrNotPositive)
+}
+
+func IsPositiveOrZero[T validation.Numeric]() SignConstraint[T] {
+	return newSignConstraint[T](positiveOrZeroSign, validation.ErrNotPositiveOrZero)
+}
+
+func IsNegative[T validation.Numeric]() SignConstraint[T] {
+	return newSignConstraint[T](negativeSign, validation.ErrNotNegative)
+}
+
+func IsNegativeOrZero[T validation.Numeric]() SignConstraint[T] {
+	return newSignConstraint[T](negativeOrZeroSign, validation.ErrNotNegativeOrZero)
+}
+
+func (c SignConstraint[T]) WithError(err error) SignConstraint[T] {
+	c.err = err
+	return c
+}
+
+func (c SignConstraint[T]) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) SignConstraint[T] {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c SignConstraint[T]) When(condition bool) SignConstraint[T] {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c SignConstraint[T]) WhenGroups(groups ...string) SignConstraint[T] {
+	c.groups = groups
+	return c
+}
+
+func (c SignConstraint[T]) ValidateNumber(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *T,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil ||
+		c.isValid(*value) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", fmt.Sprint(*value)),
+			)...,
+		).
+		Create()
+}
+
+func (c SignConstraint[T]) isValid(value T) bool {
+	switch c.sign {
+	case positiveSign:
+		return value > 0
+	case positiveOrZeroSign:
+		return value >= 0
+	case negativeSign:
+		return value < 0
+	case negativeOrZeroSign:
+		return value <= 0
+	}
+
+	return false
+}
+
+type FiniteConstraint[T validation.Float] struct {
+	err               error
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsFinite[T validation.Float]() FiniteConstraint[T] {
+	return FiniteConstraint[T]{
+		err:             validation.ErrNotFinite,
+		messageTemplate: validation.ErrNotFinite.Message(),
+	}
+}
+
+func (c FiniteConstraint[T]) WithError(err error) FiniteConstraint[T] {
+	c.err = err
+	return c
+}
+
+func (c FiniteConstraint[T]) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) FiniteConstraint[T] {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c FiniteConstraint[T]) When(condition bool) FiniteConstraint[T] {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c FiniteConstraint[T]) WhenGroups(groups ...string) FiniteConstraint[T] {
+	c.groups = groups
+	return c
+}
+
+func (c FiniteConstraint[T]) ValidateNumber(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *T,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil ||
+		!math.IsInf(float64(*value), 0) && !math.IsNaN(float64(*value)) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", fmt.Sprint(*value)),
+			)...,
+		).
+		Create()
+}
+
+type NaNConstraint[T validation.Float] struct {
+	err               error
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsNaN[T validation.Float]() NaNConstraint[T] {
+	return NaNConstraint[T]{
+		err:             validation.ErrNotNaN,
+		messageTemplate: validation.ErrNotNaN.Message(),
+	}
+}
+
+func (c NaNConstraint[T]) WithError(err error) NaNConstraint[T] {
+	c.err = err
+	return c
+}
+
+func (c NaNConstraint[T]) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) NaNConstraint[T] {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c NaNConstraint[T]) When(condition bool) NaNConstraint[T] {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c NaNConstraint[T]) WhenGroups(groups ...string) NaNConstraint[T] {
+	c.groups = groups
+	return c
+}
+
+func (c NaNConstraint[T]) ValidateNumber(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *T,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil ||
+		math.IsNaN(float64(*value)) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", fmt.Sprint(*value)),
+			)...,
+		).
+		Create()
+}
+
+type RangeConstraint[T validation.Numeric] struct {
+	minErr               error
+	maxErr               error
+	min                  T
+	max                  T
+	minMessageTemplate   string
+	maxMessageTemplate   string
+	groups               []string
+	minMessageParameters validation.TemplateParameterList
+	maxMessageParameters validation.TemplateParameterList
+	isExclusiveMin       bool
+	isExclusiveMax       bool
+	isIgnored            bool
+}
+
+func IsInRange[T validation.Numeric](min, max T) RangeConstraint[T] {
+	return RangeConstraint[T]{min: min, max: max}
+}
+
+func (c RangeConstraint[T]) WithExclusiveMin() RangeConstraint[T] {
+	c.isExclusiveMin = true
+	return c
+}
+
+func (c RangeConstraint[T]) WithExclusiveMax() RangeConstraint[T] {
+	c.isExclusiveMax = true
+	return c
+}
+
+func (c RangeConstraint[T]) WithMinError(err error) RangeConstraint[T] {
+	c.minErr = err
+	return c
+}
+
+func (c RangeConstraint[T]) WithMaxError(err error) RangeConstraint[T] {
+	c.maxErr = err
+	return c
+}
+
+func (c RangeConstraint[T]) WithMinMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) RangeConstraint[T] {
+	c.minMessageTemplate = template
+	c.minMessageParameters = parameters
+
+	return c
+}
+
+func (c RangeConstraint[T]) WithMaxMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) RangeConstraint[T] {
+	c.maxMessageTemplate = template
+	c.maxMessageParameters = parameters
+
+	return c
+}
+
+func (c RangeConstraint[T]) When(condition bool) RangeConstraint[T] {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c RangeConstraint[T]) WhenGroups(groups ...string) RangeConstraint[T] {
+	c.groups = groups
+	return c
+}
+
+func (c RangeConstraint[T]) ValidateNumber(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *T,
+) error {
+	if c.min > c.max {
+		return validator.CreateConstraintError(
+			"RangeConstraint",
+			"min must be less than or equal to max",
+		)
+	}
+
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil {
+		return nil
+	}
+
+	if *value < c.min || c.isExclusiveMin && *value == c.min {
+		err, template := rangeViolationError(
+			c.minErr,
+			c.minMessageTemplate,
+			c.isExclusiveMin,
+			validation.ErrTooLowOrEqual,
+			validation.ErrTooLow,
+		)
+
+		return c.newViolation(
+			ctx,
+			validator,
+			*value,
+			c.min,
+			err,
+			template,
+			c.minMessageParameters,
+		)
+	}
+
+	if *value > c.max || c.isExclusiveMax && *value == c.max {
+		err, template := rangeViolationError(
+			c.maxErr,
+			c.maxMessageTemplate,
+			c.isExclusiveMax,
+			validation.ErrTooHighOrEqual,
+			validation.ErrTooHigh,
+		)
+
+		return c.newViolation(
+			ctx,
+			validator,
+			*value,
+			c.max,
+			err,
+			template,
+			c.maxMessageParameters,
+		)
+	}
+
+	return nil
+}
+
+func (c RangeConstraint[T]) newViolation(
+	ctx context.Context,
+	validator *validation.Validator,
+	value, limit T,
+	err error,
+	template string,
+	parameters validation.TemplateParameterList,
+) validation.Violation {
+	return validator.
+		BuildViolation(ctx, err, template).
+		WithParameters(
+			parameters.Prepend(
+				validation.StringParam("{{ value }}", fmt.Sprint(value)),
+				validation.StringParam("{{ min }}", fmt.Sprint(c.min)),
+				validation.StringParam("{{ max }}", fmt.Sprint(c.max)),
+				validation.StringParam("{{ comparedValue }}", fmt.Sprint(limit)),
+			)...,
+		).
+		Create()
+}
+
+func rangeViolationError(
+	err error,
+	template string,
+	isExclusive bool,
+	inclusiveErr, exclusiveErr *validation.Error,
+) (error, string) {
+	defaultErr := inclusiveErr
+	if isExclusive {
+		defaultErr = exclusiveErr
+	}
+
+	if err == nil {
+		err = defaultErr
+	}
+
+	if template == "" {
+		template = defaultErr.Message()
+	}
+
+	return err, template
+}
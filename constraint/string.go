@@ -2,8 +2,11 @@ package constraint
 
 import (
 	"context"
+	"io"
 	"regexp"
 	"strconv"
+	"strings"
+	"unicode"
 	"unicode/utf8"
 
 	"line/predicate"
@@ -114,6 +117,22 @@ func (c LengthConstraint) WithExactMessage(
 	return c
 }
 
+func (c LengthConstraint) Min() int {
+	return c.min
+}
+
+func (c LengthConstraint) Max() int {
+	return c.max
+}
+
+func (c LengthConstraint) HasMin() bool {
+	return c.checkMin
+}
+
+func (c LengthConstraint) HasMax() bool {
+	return c.checkMax
+}
+
 func (c LengthConstraint) ValidateString(
 	ctx context.Context,
 	validator *validation.Validator,
@@ -157,6 +176,23 @@ func (c LengthConstraint) ValidateString(
 	return nil
 }
 
+func (c LengthConstraint) ValidateComparables(
+	ctx context.Context,
+	validator *validation.Validator,
+	values []string,
+) error {
+	violations := validation.NewViolationList()
+
+	for i := range values {
+		err := violations.AppendFromError(c.ValidateString(ctx, validator.AtIndex(i), &values[i]))
+		if err != nil {
+			return err
+		}
+	}
+
+	return violations.AsError()
+}
+
 func (c LengthConstraint) newViolation(
 	ctx context.Context,
 	validator *validation.Validator,
@@ -178,8 +214,8 @@ func (c LengthConstraint) newViolation(
 		WithParameters(
 			parameters.Prepend(
 				validation.TemplateParameter{Key: "{{ value }}", Value: strconv.Quote(value)},
-				validation.TemplateParameter{Key: "{{ length }}", Value: strconv.Itoa(count)},
-				validation.TemplateParameter{Key: "{{ limit }}", Value: strconv.Itoa(limit)},
+				validation.IntParam("{{ length }}", count),
+				validation.IntParam("{{ limit }}", limit),
 			)...,
 		).
 		Create()
@@ -238,6 +274,18 @@ func (c RegexpConstraint) WhenGroups(groups ...string) RegexpConstraint {
 	return c
 }
 
+func (c RegexpConstraint) Pattern() string {
+	if c.regex == nil {
+		return ""
+	}
+
+	return c.regex.String()
+}
+
+func (c RegexpConstraint) IsMatch() bool {
+	return c.match
+}
+
 func (c RegexpConstraint) ValidateString(
 	ctx context.Context,
 	validator *validation.Validator,
@@ -272,6 +320,34 @@ func IsJSON() validation.StringFuncConstraint {
 		WithMessage(validation.ErrInvalidJSON.Message())
 }
 
+func IsJSONObject() validation.StringFuncConstraint {
+	return validation.
+		OfStringBy(predicate.JSONObject).
+		WithError(validation.ErrInvalidJSON).
+		WithMessage(validation.ErrInvalidJSON.Message())
+}
+
+func IsJSONArray() validation.StringFuncConstraint {
+	return validation.
+		OfStringBy(predicate.JSONArray).
+		WithError(validation.ErrInvalidJSON).
+		WithMessage(validation.ErrInvalidJSON.Message())
+}
+
+func IsYAML() validation.StringFuncConstraint {
+	return validation.
+		OfStringBy(predicate.YAML).
+		WithError(validation.ErrInvalidYAML).
+		WithMessage(validation.ErrInvalidYAML.Message())
+}
+
+func IsXML() validation.StringFuncConstraint {
+	return validation.
+		OfStringBy(predicate.XML).
+		WithError(validation.ErrInvalidXML).
+		WithMessage(validation.ErrInvalidXML.Message())
+}
+
 func IsInteger() validation.StringFuncConstraint {
 	return validation.
 		OfStringBy(predicate.Integer).
@@ -285,3 +361,1046 @@ func IsNumeric() validation.StringFuncConstraint {
 		WithError(validation.ErrNotNumeric).
 		WithMessage(validation.ErrNotNumeric.Message())
 }
+
+func IsAlpha() validation.StringFuncConstraint {
+	return validation.OfStringBy(predicate.Alpha)
+}
+
+func IsAlphanumeric() validation.StringFuncConstraint {
+	return validation.OfStringBy(predicate.Alphanumeric)
+}
+
+func IsASCII() validation.StringFuncConstraint {
+	return validation.OfStringBy(predicate.ASCII)
+}
+
+func IsLowerCase() validation.StringFuncConstraint {
+	return validation.OfStringBy(predicate.LowerCase)
+}
+
+func IsUpperCase() validation.StringFuncConstraint {
+	return validation.OfStringBy(predicate.UpperCase)
+}
+
+func IsTitleCase() validation.StringFuncConstraint {
+	return validation.OfStringBy(predicate.TitleCase)
+}
+
+type JSONReaderConstraint struct {
+	validation.BaseConstraint
+}
+
+func IsJSONReader() JSONReaderConstraint {
+	return JSONReaderConstraint{
+		BaseConstraint: validation.BaseConstraint{
+			Err:             validation.ErrInvalidJSON,
+			MessageTemplate: validation.ErrInvalidJSON.Message(),
+		},
+	}
+}
+
+func (c JSONReaderConstraint) When(condition bool) JSONReaderConstraint {
+	c.BaseConstraint = c.BaseConstraint.When(condition)
+	return c
+}
+
+func (c JSONReaderConstraint) WhenGroups(groups ...string) JSONReaderConstraint {
+	c.BaseConstraint = c.BaseConstraint.WhenGroups(groups...)
+	return c
+}
+
+func (c JSONReaderConstraint) WithError(err error) JSONReaderConstraint {
+	c.BaseConstraint = c.BaseConstraint.WithError(err)
+	return c
+}
+
+func (c JSONReaderConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) JSONReaderConstraint {
+	c.BaseConstraint = c.BaseConstraint.WithMessage(template, parameters...)
+
+	return c
+}
+
+func (c JSONReaderConstraint) ValidateReader(
+	ctx context.Context,
+	validator *validation.Validator,
+	value io.Reader,
+) error {
+	if c.ShouldSkip(validator) || value == nil || predicate.JSONStream(value) {
+		return nil
+	}
+
+	return c.NewViolation(ctx, validator)
+}
+
+type PrefixConstraint struct {
+	err               error
+	prefix            string
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func HasPrefix(prefix string) PrefixConstraint {
+	return PrefixConstraint{
+		prefix:          prefix,
+		err:             validation.ErrInvalidPrefix,
+		messageTemplate: validation.ErrInvalidPrefix.Message(),
+	}
+}
+
+func (c PrefixConstraint) WithError(err error) PrefixConstraint {
+	c.err = err
+	return c
+}
+
+func (c PrefixConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) PrefixConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c PrefixConstraint) When(condition bool) PrefixConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c PrefixConstraint) WhenGroups(groups ...string) PrefixConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c PrefixConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if strings.HasPrefix(*value, c.prefix) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+				validation.StringParam("{{ prefix }}", c.prefix),
+			)...,
+		).
+		Create()
+}
+
+type SuffixConstraint struct {
+	err               error
+	suffix            string
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func HasSuffix(suffix string) SuffixConstraint {
+	return SuffixConstraint{
+		suffix:          suffix,
+		err:             validation.ErrInvalidSuffix,
+		messageTemplate: validation.ErrInvalidSuffix.Message(),
+	}
+}
+
+func (c SuffixConstraint) WithError(err error) SuffixConstraint {
+	c.err = err
+	return c
+}
+
+func (c SuffixConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) SuffixConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c SuffixConstraint) When(condition bool) SuffixConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c SuffixConstraint) WhenGroups(groups ...string) SuffixConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c SuffixConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if strings.HasSuffix(*value, c.suffix) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+				validation.StringParam("{{ suffix }}", c.suffix),
+			)...,
+		).
+		Create()
+}
+
+type TrimmedConstraint struct {
+	err               error
+	cutset            *string
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsTrimmed() TrimmedConstraint {
+	return TrimmedConstraint{
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c TrimmedConstraint) WithTrimCutset(cutset string) TrimmedConstraint {
+	c.cutset = &cutset
+	return c
+}
+
+func (c TrimmedConstraint) WithError(err error) TrimmedConstraint {
+	c.err = err
+	return c
+}
+
+func (c TrimmedConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) TrimmedConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c TrimmedConstraint) When(condition bool) TrimmedConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c TrimmedConstraint) WhenGroups(groups ...string) TrimmedConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c TrimmedConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	trimmed := strings.TrimSpace(*value)
+	if c.cutset != nil {
+		trimmed = strings.Trim(*value, *c.cutset)
+	}
+
+	if trimmed == *value {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
+
+type ContainsConstraint struct {
+	err               error
+	substring         string
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	caseFold          bool
+	isIgnored         bool
+}
+
+func Contains(substring string) ContainsConstraint {
+	return ContainsConstraint{
+		substring:       substring,
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c ContainsConstraint) WithCaseFold() ContainsConstraint {
+	c.caseFold = true
+	return c
+}
+
+func (c ContainsConstraint) WithError(err error) ContainsConstraint {
+	c.err = err
+	return c
+}
+
+func (c ContainsConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) ContainsConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c ContainsConstraint) When(condition bool) ContainsConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c ContainsConstraint) WhenGroups(groups ...string) ContainsConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c ContainsConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if containsSubstring(*value, c.substring, c.caseFold) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+				validation.StringParam("{{ substring }}", c.substring),
+			)...,
+		).
+		Create()
+}
+
+type DoesNotContainConstraint struct {
+	err               error
+	substring         string
+	messageTemplate   string
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	caseFold          bool
+	isIgnored         bool
+}
+
+func DoesNotContain(substring string) DoesNotContainConstraint {
+	return DoesNotContainConstraint{
+		substring:       substring,
+		err:             validation.ErrNotValid,
+		messageTemplate: validation.ErrNotValid.Message(),
+	}
+}
+
+func (c DoesNotContainConstraint) WithCaseFold() DoesNotContainConstraint {
+	c.caseFold = true
+	return c
+}
+
+func (c DoesNotContainConstraint) WithError(err error) DoesNotContainConstraint {
+	c.err = err
+	return c
+}
+
+func (c DoesNotContainConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) DoesNotContainConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c DoesNotContainConstraint) When(condition bool) DoesNotContainConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c DoesNotContainConstraint) WhenGroups(groups ...string) DoesNotContainConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c DoesNotContainConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if !containsSubstring(*value, c.substring, c.caseFold) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+				validation.StringParam("{{ substring }}", c.substring),
+			)...,
+		).
+		Create()
+}
+
+func containsSubstring(value, substring string, caseFold bool) bool {
+	if caseFold {
+		return predicate.ContainsFold(value, substring)
+	}
+
+	return strings.Contains(value, substring)
+}
+
+type WordCountConstraint struct {
+	minErr               error
+	maxErr               error
+	splitter             func(string) []string
+	minMessageTemplate   string
+	maxMessageTemplate   string
+	groups               []string
+	minMessageParameters validation.TemplateParameterList
+	maxMessageParameters validation.TemplateParameterList
+	max                  int
+	min                  int
+	checkMax             bool
+	checkMin             bool
+	isIgnored            bool
+}
+
+func newWordCountConstraint(min, max int, checkMin, checkMax bool) WordCountConstraint {
+	return WordCountConstraint{
+		min:                min,
+		max:                max,
+		checkMin:           checkMin,
+		checkMax:           checkMax,
+		splitter:           strings.Fields,
+		minErr:             validation.ErrTooFewWords,
+		maxErr:             validation.ErrTooManyWords,
+		minMessageTemplate: validation.ErrTooFewWords.Message(),
+		maxMessageTemplate: validation.ErrTooManyWords.Message(),
+	}
+}
+
+func HasMinWordCount(min int) WordCountConstraint {
+	return newWordCountConstraint(min, 0, true, false)
+}
+
+func HasMaxWordCount(max int) WordCountConstraint {
+	return newWordCountConstraint(0, max, false, true)
+}
+
+func (c WordCountConstraint) WithSplitter(splitter func(string) []string) WordCountConstraint {
+	c.splitter = splitter
+	return c
+}
+
+func (c WordCountConstraint) When(condition bool) WordCountConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c WordCountConstraint) WhenGroups(groups ...string) WordCountConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c WordCountConstraint) WithMinError(err error) WordCountConstraint {
+	c.minErr = err
+	return c
+}
+
+func (c WordCountConstraint) WithMaxError(err error) WordCountConstraint {
+	c.maxErr = err
+	return c
+}
+
+func (c WordCountConstraint) WithMinMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) WordCountConstraint {
+	c.minMessageTemplate = template
+	c.minMessageParameters = parameters
+
+	return c
+}
+
+func (c WordCountConstraint) WithMaxMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) WordCountConstraint {
+	c.maxMessageTemplate = template
+	c.maxMessageParameters = parameters
+
+	return c
+}
+
+func (c WordCountConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) {
+		return nil
+	}
+
+	if c.splitter == nil {
+		return validator.CreateConstraintError("WordCountConstraint", "nil splitter")
+	}
+
+	if value == nil || *value == "" {
+		return nil
+	}
+
+	count := len(c.splitter(*value))
+
+	if c.checkMax && count > c.max {
+		return c.newViolation(
+			ctx,
+			validator,
+			count,
+			c.max,
+			c.maxErr,
+			c.maxMessageTemplate,
+			c.maxMessageParameters,
+		)
+	}
+
+	if c.checkMin && count < c.min {
+		return c.newViolation(
+			ctx,
+			validator,
+			count,
+			c.min,
+			c.minErr,
+			c.minMessageTemplate,
+			c.minMessageParameters,
+		)
+	}
+
+	return nil
+}
+
+func (c WordCountConstraint) newViolation(
+	ctx context.Context,
+	validator *validation.Validator,
+	count, limit int,
+	err error,
+	template string,
+	parameters validation.TemplateParameterList,
+) validation.Violation {
+	return validator.
+		BuildViolation(ctx, err, template).
+		WithParameters(
+			parameters.Prepend(
+				validation.IntParam("{{ count }}", count),
+				validation.IntParam("{{ limit }}", limit),
+			)...,
+		).
+		Create()
+}
+
+type SlugConstraint struct {
+	err                  error
+	maxErr               error
+	messageTemplate      string
+	maxMessageTemplate   string
+	groups               []string
+	messageParameters    validation.TemplateParameterList
+	maxMessageParameters validation.TemplateParameterList
+	maxLength            int
+	isIgnored            bool
+}
+
+func IsSlug() SlugConstraint {
+	return SlugConstraint{
+		err:                validation.ErrNotValid,
+		maxErr:             validation.ErrTooLong,
+		messageTemplate:    validation.ErrNotValid.Message(),
+		maxMessageTemplate: validation.ErrTooLong.Message(),
+	}
+}
+
+func (c SlugConstraint) WithMaxLength(n int) SlugConstraint {
+	c.maxLength = n
+	return c
+}
+
+func (c SlugConstraint) WithMaxLengthError(err error) SlugConstraint {
+	c.maxErr = err
+	return c
+}
+
+func (c SlugConstraint) WithMaxLengthMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) SlugConstraint {
+	c.maxMessageTemplate = template
+	c.maxMessageParameters = parameters
+
+	return c
+}
+
+func (c SlugConstraint) WithError(err error) SlugConstraint {
+	c.err = err
+	return c
+}
+
+func (c SlugConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) SlugConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c SlugConstraint) When(condition bool) SlugConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c SlugConstraint) WhenGroups(groups ...string) SlugConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c SlugConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.maxLength < 0 {
+		return validator.CreateConstraintError("SlugConstraint", "max length must not be negative")
+	}
+
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	if !predicate.Slug(*value) {
+		return validator.
+			BuildViolation(ctx, c.err, c.messageTemplate).
+			WithParameters(
+				c.messageParameters.Prepend(
+					validation.StringParam("{{ value }}", *value),
+				)...,
+			).
+			Create()
+	}
+
+	if c.maxLength > 0 && len(*value) > c.maxLength {
+		return validator.
+			BuildViolation(ctx, c.maxErr, c.maxMessageTemplate).
+			WithParameters(
+				c.maxMessageParameters.Prepend(
+					validation.StringParam("{{ value }}", *value),
+					validation.IntParam("{{ length }}", len(*value)),
+					validation.IntParam("{{ limit }}", c.maxLength),
+				)...,
+			).
+			Create()
+	}
+
+	return nil
+}
+
+type ByteLengthConstraint struct {
+	minErr               error
+	maxErr               error
+	minMessageTemplate   string
+	maxMessageTemplate   string
+	groups               []string
+	minMessageParameters validation.TemplateParameterList
+	maxMessageParameters validation.TemplateParameterList
+	max                  int
+	min                  int
+	checkMax             bool
+	checkMin             bool
+	isIgnored            bool
+}
+
+func newByteLengthConstraint(min, max int, checkMin, checkMax bool) ByteLengthConstraint {
+	return ByteLengthConstraint{
+		min:                min,
+		max:                max,
+		checkMin:           checkMin,
+		checkMax:           checkMax,
+		minErr:             validation.ErrTooShortBytes,
+		maxErr:             validation.ErrTooLongBytes,
+		minMessageTemplate: validation.ErrTooShortBytes.Message(),
+		maxMessageTemplate: validation.ErrTooLongBytes.Message(),
+	}
+}
+
+func HasMinByteLength(min int) ByteLengthConstraint {
+	return newByteLengthConstraint(min, 0, true, false)
+}
+
+func HasMaxByteLength(max int) ByteLengthConstraint {
+	return newByteLengthConstraint(0, max, false, true)
+}
+
+func HasByteLengthBetween(min, max int) ByteLengthConstraint {
+	return newByteLengthConstraint(min, max, true, true)
+}
+
+func (c ByteLengthConstraint) When(condition bool) ByteLengthConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c ByteLengthConstraint) WhenGroups(groups ...string) ByteLengthConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c ByteLengthConstraint) WithMinError(err error) ByteLengthConstraint {
+	c.minErr = err
+	return c
+}
+
+func (c ByteLengthConstraint) WithMaxError(err error) ByteLengthConstraint {
+	c.maxErr = err
+	return c
+}
+
+func (c ByteLengthConstraint) WithMinMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) ByteLengthConstraint {
+	c.minMessageTemplate = template
+	c.minMessageParameters = parameters
+
+	return c
+}
+
+func (c ByteLengthConstraint) WithMaxMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) ByteLengthConstraint {
+	c.maxMessageTemplate = template
+	c.maxMessageParameters = parameters
+
+	return c
+}
+
+func (c ByteLengthConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	count := len(*value)
+
+	if c.checkMax && count > c.max {
+		return c.newViolation(
+			ctx,
+			validator,
+			count,
+			c.max,
+			*value,
+			c.maxErr,
+			c.maxMessageTemplate,
+			c.maxMessageParameters,
+		)
+	}
+
+	if c.checkMin && count < c.min {
+		return c.newViolation(
+			ctx,
+			validator,
+			count,
+			c.min,
+			*value,
+			c.minErr,
+			c.minMessageTemplate,
+			c.minMessageParameters,
+		)
+	}
+
+	return nil
+}
+
+func (c ByteLengthConstraint) newViolation(
+	ctx context.Context,
+	validator *validation.Validator,
+	count int,
+	limit int,
+	value string,
+	err error,
+	template string,
+	parameters validation.TemplateParameterList,
+) validation.Violation {
+	return validator.
+		BuildViolation(ctx, err, template).
+		WithParameters(
+			parameters.Prepend(
+				validation.TemplateParameter{Key: "{{ value }}", Value: strconv.Quote(value)},
+				validation.IntParam("{{ length }}", count),
+				validation.IntParam("{{ limit }}", limit),
+			)...,
+		).
+		Create()
+}
+
+// PasswordConstraint reports failed requirements in the {{ missing }} parameter as a
+// comma-separated list of stable keys: min_length, uppercase, lowercase, digit and
+// special_char. The {{ minLength }} parameter holds the configured minimum length.
+type PasswordConstraint struct {
+	err                error
+	messageTemplate    string
+	groups             []string
+	messageParameters  validation.TemplateParameterList
+	minLength          int
+	requireUppercase   bool
+	requireLowercase   bool
+	requireDigit       bool
+	requireSpecialChar bool
+	isIgnored          bool
+}
+
+func HasPasswordStrength() PasswordConstraint {
+	return PasswordConstraint{
+		err:             validation.ErrWeakPassword,
+		messageTemplate: validation.ErrWeakPassword.Message(),
+	}
+}
+
+func (c PasswordConstraint) WithMinLength(n int) PasswordConstraint {
+	c.minLength = n
+	return c
+}
+
+func (c PasswordConstraint) WithRequireUppercase() PasswordConstraint {
+	c.requireUppercase = true
+	return c
+}
+
+func (c PasswordConstraint) WithRequireLowercase() PasswordConstraint {
+	c.requireLowercase = true
+	return c
+}
+
+func (c PasswordConstraint) WithRequireDigit() PasswordConstraint {
+	c.requireDigit = true
+	return c
+}
+
+func (c PasswordConstraint) WithRequireSpecialChar() PasswordConstraint {
+	c.requireSpecialChar = true
+	return c
+}
+
+func (c PasswordConstraint) WithError(err error) PasswordConstraint {
+	c.err = err
+	return c
+}
+
+func (c PasswordConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) PasswordConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c PasswordConstraint) When(condition bool) PasswordConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c PasswordConstraint) WhenGroups(groups ...string) PasswordConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c PasswordConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) || value == nil || *value == "" {
+		return nil
+	}
+
+	missing := c.missingRequirements(*value)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ missing }}", strings.Join(missing, ", ")),
+				validation.IntParam("{{ minLength }}", c.minLength),
+			)...,
+		).
+		Create()
+}
+
+func (c PasswordConstraint) missingRequirements(value string) []string {
+	var hasUpper, hasLower, hasDigit, hasSpecial bool
+
+	for _, r := range value {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsLetter(r) && !unicode.IsSpace(r):
+			hasSpecial = true
+		}
+	}
+
+	var missing []string
+
+	if utf8.RuneCountInString(value) < c.minLength {
+		missing = append(missing, "min_length")
+	}
+
+	if c.requireUppercase && !hasUpper {
+		missing = append(missing, "uppercase")
+	}
+
+	if c.requireLowercase && !hasLower {
+		missing = append(missing, "lowercase")
+	}
+
+	if c.requireDigit && !hasDigit {
+		missing = append(missing, "digit")
+	}
+
+	if c.requireSpecialChar && !hasSpecial {
+		missing = append(missing, "special_char")
+	}
+
+	return missing
+}
+
+type JSONSchemaConstraint struct {
+	err               error
+	schemaErr         error
+	messageTemplate   string
+	conforms          func(string) bool
+	groups            []string
+	messageParameters validation.TemplateParameterList
+	isIgnored         bool
+}
+
+func IsJSONConformingTo(schema []byte) JSONSchemaConstraint {
+	conforms, err := predicate.JSONConformsToSchema(schema)
+
+	return JSONSchemaConstraint{
+		conforms:        conforms,
+		schemaErr:       err,
+		err:             validation.ErrJSONSchemaMismatch,
+		messageTemplate: validation.ErrJSONSchemaMismatch.Message(),
+	}
+}
+
+func (c JSONSchemaConstraint) WithError(err error) JSONSchemaConstraint {
+	c.err = err
+	return c
+}
+
+func (c JSONSchemaConstraint) WithMessage(
+	template string,
+	parameters ...validation.TemplateParameter,
+) JSONSchemaConstraint {
+	c.messageTemplate = template
+	c.messageParameters = parameters
+
+	return c
+}
+
+func (c JSONSchemaConstraint) When(condition bool) JSONSchemaConstraint {
+	c.isIgnored = !condition
+	return c
+}
+
+func (c JSONSchemaConstraint) WhenGroups(groups ...string) JSONSchemaConstraint {
+	c.groups = groups
+	return c
+}
+
+func (c JSONSchemaConstraint) ValidateString(
+	ctx context.Context,
+	validator *validation.Validator,
+	value *string,
+) error {
+	if c.isIgnored || validator.IsIgnoredForGroups(c.groups...) {
+		return nil
+	}
+
+	if c.schemaErr != nil {
+		return validator.CreateConstraintErrorWithCause(
+			"JSONSchemaConstraint",
+			"invalid JSON schema",
+			c.schemaErr,
+		)
+	}
+
+	if value == nil || *value == "" || c.conforms(*value) {
+		return nil
+	}
+
+	return validator.
+		BuildViolation(ctx, c.err, c.messageTemplate).
+		WithParameters(
+			c.messageParameters.Prepend(
+				validation.StringParam("{{ value }}", *value),
+			)...,
+		).
+		Create()
+}
@@ -1,3 +1,8 @@
 module line
 
 go 1.25.3
+
+require (
+	golang.org/x/text v0.41.0
+	gopkg.in/yaml.v3 v3.0.1
+)
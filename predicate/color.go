@@ -0,0 +1,46 @@
+package predicate
+
+import (
+	"regexp"
+	"strconv"
+)
+
+var (
+	hexColorRegex = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3,4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+	rgbColorRegex = regexp.MustCompile(
+		`^rgb\(\s*(\d{1,3})\s*,\s*(\d{1,3})\s*,\s*(\d{1,3})\s*\)$`,
+	)
+	rgbaColorRegex = regexp.MustCompile(
+		`^rgba\(\s*(\d{1,3})\s*,\s*(\d{1,3})\s*,\s*(\d{1,3})\s*,\s*(\d*\.?\d+)\s*\)$`,
+	)
+)
+
+func HexColor(s string) bool {
+	return hexColorRegex.MatchString(s)
+}
+
+func RGBColor(s string) bool {
+	if m := rgbColorRegex.FindStringSubmatch(s); m != nil {
+		return isColorChannels(m[1:])
+	}
+
+	m := rgbaColorRegex.FindStringSubmatch(s)
+	if m == nil || !isColorChannels(m[1:4]) {
+		return false
+	}
+
+	alpha, err := strconv.ParseFloat(m[4], 64)
+
+	return err == nil && alpha >= 0 && alpha <= 1
+}
+
+func isColorChannels(channels []string) bool {
+	for _, channel := range channels {
+		n, err := strconv.Atoi(channel)
+		if err != nil || n > 255 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,45 @@
+package predicate
+
+const uuidLength = 36
+
+func UUID(s string) bool {
+	if len(s) != uuidLength {
+		return false
+	}
+
+	for i := range len(s) {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return false
+			}
+		default:
+			if !isHex(s[i]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func UUIDVersion(s string, version int) bool {
+	if !UUID(s) {
+		return false
+	}
+
+	if int(s[14]-'0') != version {
+		return false
+	}
+
+	switch s[19] {
+	case '8', '9', 'a', 'b', 'A', 'B':
+		return true
+	default:
+		return false
+	}
+}
+
+func isHex(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
@@ -1,7 +1,58 @@
 package predicate
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+)
 
 func JSON(value string) bool {
 	return json.Valid([]byte(value))
 }
+
+func JSONObject(value string) bool {
+	return firstJSONByte(value) == '{' && JSON(value)
+}
+
+func JSONArray(value string) bool {
+	return firstJSONByte(value) == '[' && JSON(value)
+}
+
+func firstJSONByte(value string) byte {
+	trimmed := strings.TrimLeft(value, " \t\r\n")
+	if trimmed == "" {
+		return 0
+	}
+
+	return trimmed[0]
+}
+
+func JSONStream(r io.Reader) bool {
+	decoder := json.NewDecoder(r)
+	depth := 0
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return false
+		}
+
+		if delim, ok := token.(json.Delim); ok {
+			switch delim {
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+			}
+		}
+
+		if depth == 0 {
+			break
+		}
+	}
+
+	_, err := decoder.Token()
+
+	return errors.Is(err, io.EOF)
+}
@@ -0,0 +1,326 @@
+package predicate
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+)
+
+// JSONConformsToSchema supports the subset of JSON Schema listed in supportedJSONSchemaKeywords
+// and returns an error for schemas using any other keyword.
+func JSONConformsToSchema(schema []byte) (func(string) bool, error) {
+	var root any
+	if err := json.Unmarshal(schema, &root); err != nil {
+		return nil, fmt.Errorf("parse JSON schema: %w", err)
+	}
+
+	if err := compileJSONSchema(root, "#"); err != nil {
+		return nil, err
+	}
+
+	return func(value string) bool {
+		var document any
+		if err := json.Unmarshal([]byte(value), &document); err != nil {
+			return false
+		}
+
+		return conformsToSchema(document, root)
+	}, nil
+}
+
+var supportedJSONSchemaKeywords = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "title": true, "description": true,
+	"default": true, "examples": true, "deprecated": true, "readOnly": true, "writeOnly": true,
+	"type": true, "enum": true, "const": true, "allOf": true, "anyOf": true, "oneOf": true,
+	"not": true, "properties": true, "required": true, "additionalProperties": true,
+	"minProperties": true, "maxProperties": true, "items": true, "uniqueItems": true,
+	"minItems": true, "maxItems": true, "pattern": true, "minLength": true, "maxLength": true,
+	"minimum": true, "maximum": true, "exclusiveMinimum": true, "exclusiveMaximum": true,
+	"multipleOf": true,
+}
+
+func compileJSONSchema(schema any, path string) error {
+	var s map[string]any
+
+	switch v := schema.(type) {
+	case bool:
+		return nil
+	case map[string]any:
+		s = v
+	default:
+		return fmt.Errorf("schema at %s must be an object or a boolean", path)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(s)) {
+		if !supportedJSONSchemaKeywords[key] {
+			return fmt.Errorf("unsupported keyword %q at %s", key, path)
+		}
+	}
+
+	if _, ok := s["items"].([]any); ok {
+		return fmt.Errorf("tuple form of \"items\" at %s is not supported", path)
+	}
+
+	if pattern, ok := s["pattern"].(string); ok {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("compile pattern at %s: %w", path, err)
+		}
+
+		s["pattern"] = compiled
+	}
+
+	if properties, ok := s["properties"].(map[string]any); ok {
+		for _, name := range slices.Sorted(maps.Keys(properties)) {
+			if err := compileJSONSchema(properties[name], path+"/properties/"+name); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, key := range []string{"items", "additionalProperties", "not"} {
+		subschema, ok := s[key]
+		if !ok {
+			continue
+		}
+
+		if err := compileJSONSchema(subschema, path+"/"+key); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range []string{"allOf", "anyOf", "oneOf"} {
+		subschemas, _ := s[key].([]any)
+		for i, subschema := range subschemas {
+			if err := compileJSONSchema(subschema, path+"/"+key+"/"+strconv.Itoa(i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func conformsToSchema(value, schema any) bool {
+	switch s := schema.(type) {
+	case bool:
+		return s
+	case map[string]any:
+		return conformsToSchemaObject(value, s)
+	default:
+		return false
+	}
+}
+
+func conformsToSchemaObject(value any, schema map[string]any) bool {
+	if t, ok := schema["type"]; ok && !conformsToSchemaType(value, t) {
+		return false
+	}
+	if enum, ok := schema["enum"].([]any); ok &&
+		!slices.ContainsFunc(enum, func(e any) bool { return isJSONEqual(e, value) }) {
+		return false
+	}
+	if c, ok := schema["const"]; ok && !isJSONEqual(c, value) {
+		return false
+	}
+	if !conformsToSchemaCombinators(value, schema) {
+		return false
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		return conformsToSchemaProperties(v, schema)
+	case []any:
+		return conformsToSchemaItems(v, schema)
+	case string:
+		return conformsToSchemaString(v, schema)
+	case float64:
+		return conformsToSchemaNumber(v, schema)
+	}
+
+	return true
+}
+
+func conformsToSchemaType(value, schemaType any) bool {
+	switch t := schemaType.(type) {
+	case string:
+		return isJSONType(value, t)
+	case []any:
+		return slices.ContainsFunc(t, func(e any) bool {
+			name, ok := e.(string)
+			return ok && isJSONType(value, name)
+		})
+	default:
+		return false
+	}
+}
+
+func isJSONType(value any, name string) bool {
+	switch v := value.(type) {
+	case nil:
+		return name == "null"
+	case bool:
+		return name == "boolean"
+	case string:
+		return name == "string"
+	case []any:
+		return name == "array"
+	case map[string]any:
+		return name == "object"
+	case float64:
+		return name == "number" || name == "integer" && v == math.Trunc(v)
+	default:
+		return false
+	}
+}
+
+func conformsToSchemaCombinators(value any, schema map[string]any) bool {
+	if all, ok := schema["allOf"].([]any); ok {
+		for _, s := range all {
+			if !conformsToSchema(value, s) {
+				return false
+			}
+		}
+	}
+	if anyOf, ok := schema["anyOf"].([]any); ok &&
+		!slices.ContainsFunc(anyOf, func(s any) bool { return conformsToSchema(value, s) }) {
+		return false
+	}
+	if oneOf, ok := schema["oneOf"].([]any); ok {
+		count := 0
+		for _, s := range oneOf {
+			if conformsToSchema(value, s) {
+				count++
+			}
+		}
+		if count != 1 {
+			return false
+		}
+	}
+	if not, ok := schema["not"]; ok && conformsToSchema(value, not) {
+		return false
+	}
+
+	return true
+}
+
+func conformsToSchemaProperties(value map[string]any, schema map[string]any) bool {
+	if required, ok := schema["required"].([]any); ok {
+		for _, name := range required {
+			key, isString := name.(string)
+			if !isString {
+				return false
+			}
+			if _, exists := value[key]; !exists {
+				return false
+			}
+		}
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	additional, hasAdditional := schema["additionalProperties"]
+
+	for key, property := range value {
+		if s, ok := properties[key]; ok {
+			if !conformsToSchema(property, s) {
+				return false
+			}
+		} else if hasAdditional && !conformsToSchema(property, additional) {
+			return false
+		}
+	}
+
+	return isWithinSchemaBounds(len(value), schema, "minProperties", "maxProperties")
+}
+
+func conformsToSchemaItems(value []any, schema map[string]any) bool {
+	if items, ok := schema["items"]; ok {
+		for _, item := range value {
+			if !conformsToSchema(item, items) {
+				return false
+			}
+		}
+	}
+	if unique, _ := schema["uniqueItems"].(bool); unique {
+		for i := range value {
+			for j := i + 1; j < len(value); j++ {
+				if isJSONEqual(value[i], value[j]) {
+					return false
+				}
+			}
+		}
+	}
+
+	return isWithinSchemaBounds(len(value), schema, "minItems", "maxItems")
+}
+
+func conformsToSchemaString(value string, schema map[string]any) bool {
+	if pattern, ok := schema["pattern"].(*regexp.Regexp); ok && !pattern.MatchString(value) {
+		return false
+	}
+
+	return isWithinSchemaBounds(utf8.RuneCountInString(value), schema, "minLength", "maxLength")
+}
+
+func conformsToSchemaNumber(value float64, schema map[string]any) bool {
+	if minimum, ok := schema["minimum"].(float64); ok && value < minimum {
+		return false
+	}
+	if maximum, ok := schema["maximum"].(float64); ok && value > maximum {
+		return false
+	}
+	if minimum, ok := schema["exclusiveMinimum"].(float64); ok && value <= minimum {
+		return false
+	}
+	if maximum, ok := schema["exclusiveMaximum"].(float64); ok && value >= maximum {
+		return false
+	}
+	if divisor, ok := schema["multipleOf"].(float64); ok && divisor > 0 {
+		quotient := value / divisor
+		if quotient != math.Trunc(quotient) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isWithinSchemaBounds(count int, schema map[string]any, minKey, maxKey string) bool {
+	if minimum, ok := schema[minKey].(float64); ok && float64(count) < minimum {
+		return false
+	}
+	if maximum, ok := schema[maxKey].(float64); ok && float64(count) > maximum {
+		return false
+	}
+
+	return true
+}
+
+func isJSONEqual(a, b any) bool {
+	switch x := a.(type) {
+	case map[string]any:
+		y, ok := b.(map[string]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for key, value := range x {
+			other, exists := y[key]
+			if !exists || !isJSONEqual(value, other) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		y, ok := b.([]any)
+
+		return ok && slices.EqualFunc(x, y, isJSONEqual)
+	default:
+		return a == b
+	}
+}
@@ -0,0 +1,52 @@
+package predicate
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+func YAML(value string) bool {
+	var v any
+	return yaml.Unmarshal([]byte(value), &v) == nil
+}
+
+// XML requires a single root element; only whitespace, comments and processing instructions
+// may appear outside it, plus a document type declaration before it.
+func XML(value string) bool {
+	decoder := xml.NewDecoder(strings.NewReader(value))
+	hasRoot := false
+	depth := 0
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return hasRoot && depth == 0
+		}
+		if err != nil {
+			return false
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if depth == 0 && hasRoot {
+				return false
+			}
+			hasRoot = true
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			if depth == 0 && strings.TrimSpace(string(t)) != "" {
+				return false
+			}
+		case xml.Directive:
+			if hasRoot {
+				return false
+			}
+		}
+	}
+}
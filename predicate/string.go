@@ -0,0 +1,189 @@
+package predicate
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var semVerRegex = regexp.MustCompile(
+	`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+		`(?:-(` + semVerIdentifier + `(?:\.` + semVerIdentifier + `)*))?` +
+		`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`,
+)
+
+var phoneE164Regex = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+
+var slugRegex = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+const semVerIdentifier = `(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)`
+
+func Alpha(s string) bool {
+	for _, c := range s {
+		if !unicode.IsLetter(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func Alphanumeric(s string) bool {
+	for _, c := range s {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func ASCII(s string) bool {
+	for i := range len(s) {
+		if s[i] > unicode.MaxASCII {
+			return false
+		}
+	}
+
+	return true
+}
+
+func LowerCase(s string) bool {
+	return strings.ToLower(s) == s
+}
+
+func UpperCase(s string) bool {
+	return strings.ToUpper(s) == s
+}
+
+// TitleCase reports whether every whitespace-separated word of s starts with
+// an upper or title case letter followed only by lower case letters.
+func TitleCase(s string) bool {
+	for _, word := range strings.Fields(s) {
+		for i, c := range word {
+			if i == 0 && unicode.IsLetter(c) && !unicode.IsUpper(c) && !unicode.IsTitle(c) {
+				return false
+			}
+
+			if i > 0 && (unicode.IsUpper(c) || unicode.IsTitle(c)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func ContainsFold(s, substring string) bool {
+	runes := []rune(s)
+	sub := []rune(substring)
+
+	for i := 0; i+len(sub) <= len(runes); i++ {
+		if equalFoldRunes(runes[i:i+len(sub)], sub) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func equalFoldRunes(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] && !strings.EqualFold(string(a[i]), string(b[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func SemVer(s string) bool {
+	return semVerRegex.MatchString(s)
+}
+
+func Slug(s string) bool {
+	return slugRegex.MatchString(s)
+}
+
+const (
+	maxHostnameLength      = 253
+	maxHostnameLabelLength = 63
+)
+
+func Hostname(s string) bool {
+	if s == "" || len(s) > maxHostnameLength {
+		return false
+	}
+
+	labels := strings.Split(s, ".")
+	for _, label := range labels {
+		if !isHostnameLabel(label) {
+			return false
+		}
+	}
+
+	return !Integer(labels[len(labels)-1])
+}
+
+func isHostnameLabel(label string) bool {
+	if label == "" || len(label) > maxHostnameLabelLength {
+		return false
+	}
+
+	if label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+
+	for i := range len(label) {
+		c := label[i]
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func PhoneE164(s string) bool {
+	return phoneE164Regex.MatchString(s)
+}
+
+const ulidLength = 26
+
+func ULID(s string) bool {
+	if len(s) != ulidLength || s[0] > '7' {
+		return false
+	}
+
+	for i := range len(s) {
+		if !isCrockfordBase32(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isCrockfordBase32(c byte) bool {
+	switch {
+	case '0' <= c && c <= '9':
+		return true
+	case 'A' <= c && c <= 'Z':
+		return c != 'I' && c != 'L' && c != 'O' && c != 'U'
+	default:
+		return false
+	}
+}
+
+// TimeZone resolves names against the tz database of the host or one embedded
+// by importing time/tzdata in the main package.
+func TimeZone(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	_, err := time.LoadLocation(s)
+
+	return err == nil
+}
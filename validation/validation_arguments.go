@@ -1,7 +1,9 @@
 package validation
 
 import (
+	"cmp"
 	"context"
+	"io"
 	"time"
 )
 
@@ -89,6 +91,18 @@ func CountableProperty(
 	return NewArgument(validateCountable(count, constraints)).At(PropertyName(name))
 }
 
+func UintCountable(count uint64, constraints ...CountableConstraint) ValidatorArgument {
+	return NewArgument(validateUintCountable(count, constraints))
+}
+
+func UintCountableProperty(
+	name string,
+	count uint64,
+	constraints ...CountableConstraint,
+) ValidatorArgument {
+	return NewArgument(validateUintCountable(count, constraints)).At(PropertyName(name))
+}
+
 func Time(value time.Time, constraints ...TimeConstraint) ValidatorArgument {
 	return NewArgument(validateTime(&value, constraints))
 }
@@ -109,6 +123,18 @@ func NilTimeProperty(
 	return NewArgument(validateTime(value, constraints)).At(PropertyName(name))
 }
 
+func Reader(value io.Reader, constraints ...ReaderConstraint) ValidatorArgument {
+	return NewArgument(validateReader(value, constraints))
+}
+
+func ReaderProperty(
+	name string,
+	value io.Reader,
+	constraints ...ReaderConstraint,
+) ValidatorArgument {
+	return NewArgument(validateReader(value, constraints)).At(PropertyName(name))
+}
+
 func Valid(value Validatable) ValidatorArgument {
 	return NewArgument(validateIt(value))
 }
@@ -133,6 +159,14 @@ func ValidMapProperty[T Validatable](name string, values map[string]T) Validator
 	return NewArgument(validateMap(values)).At(PropertyName(name))
 }
 
+func ValidSliceFuncs(values []ValidatableFunc) ValidatorArgument {
+	return NewArgument(validateSlice(values))
+}
+
+func ValidMapFuncs(values map[string]ValidatableFunc) ValidatorArgument {
+	return NewArgument(validateMap(values))
+}
+
 func Comparable[T comparable](value T, constraints ...ComparableConstraint[T]) ValidatorArgument {
 	return NewArgument(validateComparable(&value, constraints))
 }
@@ -160,6 +194,36 @@ func NilComparableProperty[T comparable](
 	return NewArgument(validateComparable(value, constraints)).At(PropertyName(name))
 }
 
+func Ordered[T cmp.Ordered](
+	value T,
+	constraints ...OrderedComparableConstraint[T],
+) ValidatorArgument {
+	return NewArgument(validateOrdered(&value, constraints))
+}
+
+func OrderedProperty[T cmp.Ordered](
+	name string,
+	value T,
+	constraints ...OrderedComparableConstraint[T],
+) ValidatorArgument {
+	return NewArgument(validateOrdered(&value, constraints)).At(PropertyName(name))
+}
+
+func NilOrdered[T cmp.Ordered](
+	value *T,
+	constraints ...OrderedComparableConstraint[T],
+) ValidatorArgument {
+	return NewArgument(validateOrdered(value, constraints))
+}
+
+func NilOrderedProperty[T cmp.Ordered](
+	name string,
+	value *T,
+	constraints ...OrderedComparableConstraint[T],
+) ValidatorArgument {
+	return NewArgument(validateOrdered(value, constraints)).At(PropertyName(name))
+}
+
 func Comparables[T comparable](
 	values []T,
 	constraints ...ComparablesConstraint[T],
@@ -214,6 +278,48 @@ func EachComparableProperty[T comparable](
 	return NewArgument(validateEachComparable(values, constraints)).At(PropertyName(name))
 }
 
+func EachStringMap(values map[string]string, constraints ...StringConstraint) ValidatorArgument {
+	return NewArgument(validateEachStringMap(values, constraints))
+}
+
+func EachStringMapProperty(
+	name string,
+	values map[string]string,
+	constraints ...StringConstraint,
+) ValidatorArgument {
+	return NewArgument(validateEachStringMap(values, constraints)).At(PropertyName(name))
+}
+
+func EachComparableMap[T comparable](
+	values map[string]T,
+	constraints ...ComparableConstraint[T],
+) ValidatorArgument {
+	return NewArgument(validateEachComparableMap(values, constraints))
+}
+
+func EachComparableMapProperty[T comparable](
+	name string,
+	values map[string]T,
+	constraints ...ComparableConstraint[T],
+) ValidatorArgument {
+	return NewArgument(validateEachComparableMap(values, constraints)).At(PropertyName(name))
+}
+
+func EachComparables[T comparable](
+	values [][]T,
+	constraints ...ComparablesConstraint[T],
+) ValidatorArgument {
+	return NewArgument(validateEachComparables(values, constraints))
+}
+
+func EachComparablesProperty[T comparable](
+	name string,
+	values [][]T,
+	constraints ...ComparablesConstraint[T],
+) ValidatorArgument {
+	return NewArgument(validateEachComparables(values, constraints)).At(PropertyName(name))
+}
+
 func CheckNoViolations(err error) ValidatorArgument {
 	return NewArgument(
 		func(ctx context.Context, validator *Validator) (*ViolationListError, error) {
@@ -234,6 +340,14 @@ func CheckProperty(name string, isValid bool) Checker {
 	return Check(isValid).At(PropertyName(name))
 }
 
+func CheckAt(isValid bool, path ...PropertyPathElement) Checker {
+	return Check(isValid).At(path...)
+}
+
+func CheckPropertyWithMessage(name string, isValid bool, message string) Checker {
+	return CheckProperty(name, isValid).WithMessage(message)
+}
+
 type ValidateFunc func(ctx context.Context, validator *Validator) (*ViolationListError, error)
 
 func NewArgument(validate ValidateFunc) ValidatorArgument {
@@ -257,8 +371,36 @@ func This[T any](v T, constraints ...Constraint[T]) ValidatorArgument {
 	)
 }
 
+// ForConstraint is the preferred way to apply a custom Constraint[T] to a single value.
+func ForConstraint[T any](value T, c Constraint[T]) ValidatorArgument {
+	return This(value, c)
+}
+
+func ForConstraints[T any](value T, constraints ...Constraint[T]) ValidatorArgument {
+	return This(value, constraints...)
+}
+
+type LazyArgument struct {
+	build func() Argument
+}
+
+func Lazy(build func() Argument) LazyArgument {
+	return LazyArgument{build: build}
+}
+
+func (arg LazyArgument) setUp(ctx *executionContext) {
+	if arg.build == nil {
+		return
+	}
+
+	if argument := arg.build(); argument != nil {
+		argument.setUp(ctx)
+	}
+}
+
 type ValidatorArgument struct {
 	validate  ValidateFunc
+	inspect   func(path []PropertyPathElement, validate ValidateFunc)
 	path      []PropertyPathElement
 	isIgnored bool
 }
@@ -273,10 +415,28 @@ func (arg ValidatorArgument) When(condition bool) ValidatorArgument {
 	return arg
 }
 
+func (arg ValidatorArgument) Inspect(
+	inspect func(path []PropertyPathElement, validate ValidateFunc),
+) ValidatorArgument {
+	arg.inspect = inspect
+	return arg
+}
+
 func (arg ValidatorArgument) setUp(ctx *executionContext) {
-	if !arg.isIgnored {
-		ctx.addValidation(arg.validate, arg.path...)
+	if arg.isIgnored {
+		return
 	}
+
+	validate := arg.validate
+	if arg.inspect != nil {
+		validate = func(ctx context.Context, validator *Validator) (*ViolationListError, error) {
+			arg.inspect(arg.path, arg.validate)
+
+			return arg.validate(ctx, validator)
+		}
+	}
+
+	ctx.addValidation(validate, arg.path...)
 }
 
 type Checker struct {
@@ -48,3 +48,14 @@ func (c BaseConstraint) NewViolation(
 		WithParameters(c.Parameters...).
 		Create()
 }
+
+func (c BaseConstraint) NewViolationWithValue(
+	ctx context.Context,
+	validator *Validator,
+	value string,
+) Violation {
+	return validator.
+		BuildViolation(ctx, c.Err, c.MessageTemplate).
+		WithParameters(c.Parameters.Prepend(TemplateParameter{Key: "{{ value }}", Value: value})...).
+		Create()
+}
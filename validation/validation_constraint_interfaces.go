@@ -1,7 +1,9 @@
 package validation
 
 import (
+	"cmp"
 	"context"
+	"io"
 	"time"
 )
 
@@ -11,6 +13,10 @@ type Numeric interface {
 		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
 }
 
+type Float interface {
+	~float32 | ~float64
+}
+
 type Constraint[T any] interface {
 	Validate(ctx context.Context, validator *Validator, v T) error
 }
@@ -35,6 +41,10 @@ type ComparableConstraint[T comparable] interface {
 	ValidateComparable(ctx context.Context, validator *Validator, value *T) error
 }
 
+type OrderedComparableConstraint[T cmp.Ordered] interface {
+	ValidateOrdered(ctx context.Context, validator *Validator, value *T) error
+}
+
 type ComparablesConstraint[T comparable] interface {
 	ValidateComparables(ctx context.Context, validator *Validator, values []T) error
 }
@@ -43,10 +53,18 @@ type CountableConstraint interface {
 	ValidateCountable(ctx context.Context, validator *Validator, count int) error
 }
 
+type UintCountableConstraint interface {
+	ValidateUint64Countable(ctx context.Context, validator *Validator, count uint64) error
+}
+
 type TimeConstraint interface {
 	ValidateTime(ctx context.Context, validator *Validator, value *time.Time) error
 }
 
+type ReaderConstraint interface {
+	ValidateReader(ctx context.Context, validator *Validator, value io.Reader) error
+}
+
 type StringFuncConstraint struct {
 	err               error
 	isValid           func(string) bool
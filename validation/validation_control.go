@@ -2,7 +2,8 @@ package validation
 
 import (
 	"context"
-	"sync"
+	"errors"
+	"time"
 )
 
 type WhenArgument struct {
@@ -49,17 +50,70 @@ func (arg WhenArgument) validate(
 	return unwrapViolationList(err)
 }
 
+type WhenFuncArgument struct {
+	condition     func() bool
+	path          []PropertyPathElement
+	thenArguments []Argument
+	elseArguments []Argument
+}
+
+func WhenFunc(condition func() bool) WhenFuncArgument {
+	return WhenFuncArgument{condition: condition}
+}
+
+func (arg WhenFuncArgument) Then(arguments ...Argument) WhenFuncArgument {
+	arg.thenArguments = arguments
+	return arg
+}
+
+func (arg WhenFuncArgument) Else(arguments ...Argument) WhenFuncArgument {
+	arg.elseArguments = arguments
+	return arg
+}
+
+func (arg WhenFuncArgument) At(path ...PropertyPathElement) WhenFuncArgument {
+	arg.path = append(arg.path, path...)
+	return arg
+}
+
+func (arg WhenFuncArgument) setUp(ctx *executionContext) {
+	ctx.addValidation(arg.validate, arg.path...)
+}
+
+func (arg WhenFuncArgument) validate(
+	ctx context.Context,
+	validator *Validator,
+) (*ViolationListError, error) {
+	if arg.condition == nil {
+		return nil, validator.CreateConstraintError("WhenFuncArgument", "nil condition")
+	}
+
+	var err error
+	if arg.condition() {
+		err = validator.Validate(ctx, arg.thenArguments...)
+	} else {
+		err = validator.Validate(ctx, arg.elseArguments...)
+	}
+
+	return unwrapViolationList(err)
+}
+
 type WhenGroupsArgument struct {
 	groups        []string
 	path          []PropertyPathElement
 	thenArguments []Argument
 	elseArguments []Argument
+	isNegated     bool
 }
 
 func WhenGroups(groups ...string) WhenGroupsArgument {
 	return WhenGroupsArgument{groups: groups}
 }
 
+func WhenNotGroups(groups ...string) WhenGroupsArgument {
+	return WhenGroupsArgument{groups: groups, isNegated: true}
+}
+
 func (arg WhenGroupsArgument) Then(arguments ...Argument) WhenGroupsArgument {
 	arg.thenArguments = arguments
 	return arg
@@ -84,7 +138,7 @@ func (arg WhenGroupsArgument) validate(
 	validator *Validator,
 ) (*ViolationListError, error) {
 	var err error
-	if validator.IsIgnoredForGroups(arg.groups...) {
+	if validator.IsIgnoredForGroups(arg.groups...) != arg.isNegated {
 		err = validator.Validate(ctx, arg.elseArguments...)
 	} else {
 		err = validator.Validate(ctx, arg.thenArguments...)
@@ -96,6 +150,7 @@ func (arg WhenGroupsArgument) validate(
 type SequentialArgument struct {
 	path      []PropertyPathElement
 	arguments []Argument
+	stopAfter int
 	isIgnored bool
 }
 
@@ -113,6 +168,15 @@ func (arg SequentialArgument) When(condition bool) SequentialArgument {
 	return arg
 }
 
+func (arg SequentialArgument) StopAfter(n int) SequentialArgument {
+	arg.stopAfter = n
+	return arg
+}
+
+func (arg SequentialArgument) WithStopAfter(n int) SequentialArgument {
+	return arg.StopAfter(n)
+}
+
 func (arg SequentialArgument) setUp(ctx *executionContext) {
 	ctx.addValidation(arg.validate, arg.path...)
 }
@@ -126,6 +190,7 @@ func (arg SequentialArgument) validate(
 	}
 
 	violations := &ViolationListError{}
+	limit := max(arg.stopAfter, 1)
 
 	for _, argument := range arg.arguments {
 		err := violations.AppendFromError(validator.Validate(ctx, argument))
@@ -133,7 +198,7 @@ func (arg SequentialArgument) validate(
 			return nil, err
 		}
 
-		if violations.len > 0 {
+		if violations.len >= limit {
 			return violations, nil
 		}
 	}
@@ -142,13 +207,26 @@ func (arg SequentialArgument) validate(
 }
 
 type AtLeastOneOfArgument struct {
-	path      []PropertyPathElement
-	arguments []Argument
-	isIgnored bool
+	err               error
+	messageTemplate   string
+	path              []PropertyPathElement
+	arguments         []Argument
+	messageParameters TemplateParameterList
+	minimum           int
+	maximum           int
+	isMinimumSet      bool
+	isMaximumSet      bool
+	isCustomViolation bool
+	isIgnored         bool
 }
 
 func AtLeastOneOf(arguments ...Argument) AtLeastOneOfArgument {
-	return AtLeastOneOfArgument{arguments: arguments}
+	return AtLeastOneOfArgument{
+		arguments:       arguments,
+		minimum:         1,
+		err:             ErrNotValid,
+		messageTemplate: ErrNotValid.Message(),
+	}
 }
 
 func (arg AtLeastOneOfArgument) At(path ...PropertyPathElement) AtLeastOneOfArgument {
@@ -161,6 +239,38 @@ func (arg AtLeastOneOfArgument) When(condition bool) AtLeastOneOfArgument {
 	return arg
 }
 
+func (arg AtLeastOneOfArgument) WithMinimum(n int) AtLeastOneOfArgument {
+	arg.minimum = n
+	arg.isMinimumSet = true
+
+	return arg
+}
+
+func (arg AtLeastOneOfArgument) WithMaximum(n int) AtLeastOneOfArgument {
+	arg.maximum = n
+	arg.isMaximumSet = true
+
+	return arg
+}
+
+func (arg AtLeastOneOfArgument) WithError(err error) AtLeastOneOfArgument {
+	arg.err = err
+	arg.isCustomViolation = true
+
+	return arg
+}
+
+func (arg AtLeastOneOfArgument) WithMessage(
+	template string,
+	parameters ...TemplateParameter,
+) AtLeastOneOfArgument {
+	arg.messageTemplate = template
+	arg.messageParameters = parameters
+	arg.isCustomViolation = true
+
+	return arg
+}
+
 func (arg AtLeastOneOfArgument) setUp(ctx *executionContext) {
 	ctx.addValidation(arg.validate, arg.path...)
 }
@@ -173,12 +283,23 @@ func (arg AtLeastOneOfArgument) validate(
 		return &ViolationListError{}, nil
 	}
 
+	if err := arg.checkBounds(validator); err != nil {
+		return nil, err
+	}
+
 	violations := &ViolationListError{}
+	passed := 0
 
 	for _, argument := range arg.arguments {
 		violation := validator.Validate(ctx, argument)
 		if violation == nil {
-			return &ViolationListError{}, nil
+			passed++
+
+			if !arg.isMaximumSet && passed >= arg.minimum {
+				return &ViolationListError{}, nil
+			}
+
+			continue
 		}
 
 		err := violations.AppendFromError(violation)
@@ -187,7 +308,232 @@ func (arg AtLeastOneOfArgument) validate(
 		}
 	}
 
-	return violations, nil
+	if passed < arg.minimum && !arg.isCustomViolation {
+		return violations, nil
+	}
+
+	if passed < arg.minimum || arg.isMaximumSet && passed > arg.maximum {
+		return NewViolationList(arg.newViolation(ctx, validator, passed)), nil
+	}
+
+	return &ViolationListError{}, nil
+}
+
+func (arg AtLeastOneOfArgument) checkBounds(validator *Validator) error {
+	switch {
+	case arg.isMinimumSet && arg.minimum < 1:
+		return validator.CreateConstraintError("AtLeastOneOfArgument", "minimum must be at least 1")
+	case arg.isMaximumSet && arg.maximum < 1:
+		return validator.CreateConstraintError("AtLeastOneOfArgument", "maximum must be at least 1")
+	case arg.isMinimumSet && arg.minimum > len(arg.arguments):
+		return validator.CreateConstraintError(
+			"AtLeastOneOfArgument",
+			"minimum is greater than the number of arguments",
+		)
+	case arg.isMaximumSet && arg.maximum < arg.minimum:
+		return validator.CreateConstraintError(
+			"AtLeastOneOfArgument",
+			"maximum is less than minimum",
+		)
+	default:
+		return nil
+	}
+}
+
+func (arg AtLeastOneOfArgument) newViolation(
+	ctx context.Context,
+	validator *Validator,
+	passed int,
+) Violation {
+	return validator.BuildViolation(ctx, arg.err, arg.messageTemplate).
+		WithParameters(
+			arg.messageParameters.Prepend(
+				IntParam("{{ passedCount }}", passed),
+			)...,
+		).
+		Create()
+}
+
+type ExactlyOneOfArgument struct {
+	err               error
+	messageTemplate   string
+	path              []PropertyPathElement
+	arguments         []Argument
+	groups            []string
+	messageParameters TemplateParameterList
+	isIgnored         bool
+}
+
+func ExactlyOneOf(arguments ...Argument) ExactlyOneOfArgument {
+	return ExactlyOneOfArgument{
+		arguments:       arguments,
+		err:             ErrNotValid,
+		messageTemplate: ErrNotValid.Message(),
+	}
+}
+
+func (arg ExactlyOneOfArgument) At(path ...PropertyPathElement) ExactlyOneOfArgument {
+	arg.path = append(arg.path, path...)
+	return arg
+}
+
+func (arg ExactlyOneOfArgument) When(condition bool) ExactlyOneOfArgument {
+	arg.isIgnored = !condition
+	return arg
+}
+
+func (arg ExactlyOneOfArgument) WhenGroups(groups ...string) ExactlyOneOfArgument {
+	arg.groups = groups
+	return arg
+}
+
+func (arg ExactlyOneOfArgument) WithError(err error) ExactlyOneOfArgument {
+	arg.err = err
+	return arg
+}
+
+func (arg ExactlyOneOfArgument) WithMessage(
+	template string,
+	parameters ...TemplateParameter,
+) ExactlyOneOfArgument {
+	arg.messageTemplate = template
+	arg.messageParameters = parameters
+
+	return arg
+}
+
+func (arg ExactlyOneOfArgument) setUp(ctx *executionContext) {
+	ctx.addValidation(arg.validate, arg.path...)
+}
+
+func (arg ExactlyOneOfArgument) validate(
+	ctx context.Context,
+	validator *Validator,
+) (*ViolationListError, error) {
+	if arg.isIgnored || validator.IsIgnoredForGroups(arg.groups...) {
+		return &ViolationListError{}, nil
+	}
+
+	passed, err := countPassedArguments(ctx, validator, arg.arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	if passed == 1 {
+		return &ViolationListError{}, nil
+	}
+
+	violation := validator.BuildViolation(ctx, arg.err, arg.messageTemplate).
+		WithParameters(
+			arg.messageParameters.Prepend(
+				IntParam("{{ validCount }}", passed),
+			)...,
+		).
+		Create()
+
+	return NewViolationList(violation), nil
+}
+
+type NoneOfArgument struct {
+	err               error
+	messageTemplate   string
+	path              []PropertyPathElement
+	arguments         []Argument
+	groups            []string
+	messageParameters TemplateParameterList
+	isIgnored         bool
+}
+
+func NoneOf(arguments ...Argument) NoneOfArgument {
+	return NoneOfArgument{
+		arguments:       arguments,
+		err:             ErrNotValid,
+		messageTemplate: ErrNotValid.Message(),
+	}
+}
+
+func (arg NoneOfArgument) At(path ...PropertyPathElement) NoneOfArgument {
+	arg.path = append(arg.path, path...)
+	return arg
+}
+
+func (arg NoneOfArgument) When(condition bool) NoneOfArgument {
+	arg.isIgnored = !condition
+	return arg
+}
+
+func (arg NoneOfArgument) WhenGroups(groups ...string) NoneOfArgument {
+	arg.groups = groups
+	return arg
+}
+
+func (arg NoneOfArgument) WithError(err error) NoneOfArgument {
+	arg.err = err
+	return arg
+}
+
+func (arg NoneOfArgument) WithMessage(
+	template string,
+	parameters ...TemplateParameter,
+) NoneOfArgument {
+	arg.messageTemplate = template
+	arg.messageParameters = parameters
+
+	return arg
+}
+
+func (arg NoneOfArgument) setUp(ctx *executionContext) {
+	ctx.addValidation(arg.validate, arg.path...)
+}
+
+func (arg NoneOfArgument) validate(
+	ctx context.Context,
+	validator *Validator,
+) (*ViolationListError, error) {
+	if arg.isIgnored || validator.IsIgnoredForGroups(arg.groups...) {
+		return &ViolationListError{}, nil
+	}
+
+	passed, err := countPassedArguments(ctx, validator, arg.arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	if passed == 0 {
+		return &ViolationListError{}, nil
+	}
+
+	violation := validator.BuildViolation(ctx, arg.err, arg.messageTemplate).
+		WithParameters(
+			arg.messageParameters.Prepend(
+				IntParam("{{ passedCount }}", passed),
+			)...,
+		).
+		Create()
+
+	return NewViolationList(violation), nil
+}
+
+func countPassedArguments(
+	ctx context.Context,
+	validator *Validator,
+	arguments []Argument,
+) (int, error) {
+	passed := 0
+
+	for _, argument := range arguments {
+		err := validator.Validate(ctx, argument)
+		if err == nil {
+			passed++
+			continue
+		}
+
+		if !IsViolationList(err) {
+			return 0, err
+		}
+	}
+
+	return passed, nil
 }
 
 type AllArgument struct {
@@ -204,6 +550,10 @@ func AtProperty(propertyName string, arguments ...Argument) AllArgument {
 	return All(arguments...).At(PropertyName(propertyName))
 }
 
+func AtIndex(index int, arguments ...Argument) AllArgument {
+	return All(arguments...).At(ArrayIndex(index))
+}
+
 func (arg AllArgument) At(path ...PropertyPathElement) AllArgument {
 	arg.path = append(arg.path, path...)
 	return arg
@@ -238,10 +588,38 @@ func (arg AllArgument) validate(
 	return violations, nil
 }
 
+func Merge(arguments ...Argument) ValidatorArgument {
+	flattened := flattenArguments(nil, arguments)
+
+	return NewArgument(
+		func(ctx context.Context, validator *Validator) (*ViolationListError, error) {
+			return unwrapViolationList(validator.Validate(ctx, flattened...))
+		},
+	)
+}
+
+func flattenArguments(flattened, arguments []Argument) []Argument {
+	for _, argument := range arguments {
+		all, ok := argument.(AllArgument)
+		if !ok || len(all.path) > 0 {
+			flattened = append(flattened, argument)
+			continue
+		}
+
+		if !all.isIgnored {
+			flattened = flattenArguments(flattened, all.arguments)
+		}
+	}
+
+	return flattened
+}
+
 type AsyncArgument struct {
 	path      []PropertyPathElement
 	arguments []Argument
+	timeout   time.Duration
 	isIgnored bool
+	isOrdered bool
 }
 
 func Async(arguments ...Argument) AsyncArgument {
@@ -258,10 +636,25 @@ func (arg AsyncArgument) When(condition bool) AsyncArgument {
 	return arg
 }
 
+func (arg AsyncArgument) WithOrdered() AsyncArgument {
+	arg.isOrdered = true
+	return arg
+}
+
+func (arg AsyncArgument) WithTimeout(d time.Duration) AsyncArgument {
+	arg.timeout = d
+	return arg
+}
+
 func (arg AsyncArgument) setUp(ctx *executionContext) {
 	ctx.addValidation(arg.validate, arg.path...)
 }
 
+type asyncResult struct {
+	err   error
+	index int
+}
+
 func (arg AsyncArgument) validate(
 	ctx context.Context,
 	validator *Validator,
@@ -270,30 +663,62 @@ func (arg AsyncArgument) validate(
 		return &ViolationListError{}, nil
 	}
 
+	parent := ctx
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	waiter := &sync.WaitGroup{}
-	waiter.Add(len(arg.arguments))
+	var timeout <-chan struct{}
 
-	errs := make(chan error)
+	if arg.timeout > 0 {
+		var cancelTimeout context.CancelFunc
 
-	for _, argument := range arg.arguments {
-		go func(argument Argument) {
-			defer waiter.Done()
+		ctx, cancelTimeout = context.WithTimeout(ctx, arg.timeout)
+		defer cancelTimeout()
 
-			errs <- validator.Validate(ctx, argument)
-		}(argument)
+		timeout = ctx.Done()
 	}
 
-	go func() {
-		waiter.Wait()
-		close(errs)
-	}()
+	results := make(chan asyncResult, len(arg.arguments))
+
+	for i, argument := range arg.arguments {
+		go func(i int, argument Argument) {
+			results <- asyncResult{index: i, err: validator.Validate(ctx, argument)}
+		}(i, argument)
+	}
+
+	errs := make([]error, len(arg.arguments))
+
+	for received := range len(arg.arguments) {
+		select {
+		case result := <-results:
+			if result.err != nil && !IsViolationList(result.err) {
+				cancel()
+				drainAsyncResults(results, len(arg.arguments)-received-1)
+
+				return nil, result.err
+			}
+
+			slot := received
+			if arg.isOrdered {
+				slot = result.index
+			}
+
+			errs[slot] = result.err
+		case <-timeout:
+			if !errors.Is(ctx.Err(), context.DeadlineExceeded) || parent.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			return nil, validator.CreateConstraintError(
+				"AsyncArgument",
+				"validation timed out after "+arg.timeout.String(),
+			)
+		}
+	}
 
 	violations := &ViolationListError{}
 
-	for violation := range errs {
+	for _, violation := range errs {
 		err := violations.AppendFromError(violation)
 		if err != nil {
 			return nil, err
@@ -302,3 +727,9 @@ func (arg AsyncArgument) validate(
 
 	return violations, nil
 }
+
+func drainAsyncResults(results <-chan asyncResult, count int) {
+	for range count {
+		<-results
+	}
+}
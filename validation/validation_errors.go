@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -8,65 +9,103 @@ import (
 )
 
 var (
-	ErrInvalidDate       = NewError("invalid date", message.InvalidDate)
-	ErrInvalidDateTime   = NewError("invalid datetime", message.InvalidDateTime)
-	ErrInvalidJSON       = NewError("invalid JSON", message.InvalidJSON)
-	ErrInvalidTime       = NewError("invalid time", message.InvalidTime)
-	ErrIsBlank           = NewError("is blank", message.IsBlank)
-	ErrIsEqual           = NewError("is equal", message.IsEqual)
-	ErrIsNil             = NewError("is nil", message.IsNil)
-	ErrNoSuchChoice      = NewError("no such choice", message.NoSuchChoice)
-	ErrNotBlank          = NewError("is not blank", message.NotBlank)
-	ErrNotDivisible      = NewError("is not divisible", message.NotDivisible)
-	ErrNotDivisibleCount = NewError("not divisible count", message.NotDivisibleCount)
-	ErrNotEqual          = NewError("is not equal", message.NotEqual)
-	ErrNotExactCount     = NewError("not exact count", message.NotExactCount)
-	ErrNotExactLength    = NewError("not exact length", message.NotExactLength)
-	ErrNotFalse          = NewError("is not false", message.NotFalse)
-	ErrNotInRange        = NewError("is not in range", message.NotInRange)
-	ErrNotInteger        = NewError("is not an integer", message.NotInteger)
-	ErrNotNegative       = NewError("is not negative", message.NotNegative)
-	ErrNotNegativeOrZero = NewError("is not negative or zero", message.NotNegativeOrZero)
-	ErrNotNil            = NewError("is not nil", message.NotNil)
-	ErrNotNumeric        = NewError("is not numeric", message.NotNumeric)
-	ErrNotPositive       = NewError("is not positive", message.NotPositive)
-	ErrNotPositiveOrZero = NewError("is not positive or zero", message.NotPositiveOrZero)
-	ErrNotTrue           = NewError("is not true", message.NotTrue)
-	ErrNotUnique         = NewError("is not unique", message.NotUnique)
-	ErrNotValid          = NewError("is not valid", message.NotValid)
-	ErrProhibitedIP      = NewError("is prohibited IP", message.ProhibitedIP)
-	ErrProhibitedURL     = NewError("is prohibited URL", message.ProhibitedURL)
-	ErrTooEarly          = NewError("is too early", message.TooEarly)
-	ErrTooEarlyOrEqual   = NewError("is too early or equal", message.TooEarlyOrEqual)
-	ErrTooFewElements    = NewError("too few elements", message.TooFewElements)
-	ErrTooHigh           = NewError("is too high", message.TooHigh)
-	ErrTooHighOrEqual    = NewError("is too high or equal", message.TooHighOrEqual)
-	ErrTooLate           = NewError("is too late", message.TooLate)
-	ErrTooLateOrEqual    = NewError("is too late or equal", message.TooLateOrEqual)
-	ErrTooLong           = NewError("is too long", message.TooLong)
-	ErrTooLow            = NewError("is too low", message.TooLow)
-	ErrTooLowOrEqual     = NewError("is too low or equal", message.TooLowOrEqual)
-	ErrTooManyElements   = NewError("too many elements", message.TooManyElements)
-	ErrTooShort          = NewError("is too short", message.TooShort)
+	ErrInvalidDate        = NewError("invalid date", message.InvalidDate)
+	ErrInvalidDateTime    = NewError("invalid datetime", message.InvalidDateTime)
+	ErrInvalidIP          = NewError("invalid IP", message.InvalidIP)
+	ErrInvalidJSON        = NewError("invalid JSON", message.InvalidJSON)
+	ErrInvalidPrefix      = NewError("invalid prefix", message.InvalidPrefix)
+	ErrInvalidSuffix      = NewError("invalid suffix", message.InvalidSuffix)
+	ErrInvalidTime        = NewError("invalid time", message.InvalidTime)
+	ErrInvalidURL         = NewError("invalid URL", message.InvalidURL)
+	ErrInvalidUUID        = NewError("invalid UUID", message.InvalidUUID)
+	ErrInvalidXML         = NewError("invalid XML", message.InvalidXML)
+	ErrInvalidYAML        = NewError("invalid YAML", message.InvalidYAML)
+	ErrIsBlank            = NewError("is blank", message.IsBlank)
+	ErrIsEqual            = NewError("is equal", message.IsEqual)
+	ErrIsNil              = NewError("is nil", message.IsNil)
+	ErrJSONSchemaMismatch = NewError("JSON schema mismatch", message.JSONSchemaMismatch)
+	ErrNoSuchChoice       = NewError("no such choice", message.NoSuchChoice)
+	ErrNotBlank           = NewError("is not blank", message.NotBlank)
+	ErrNotDivisible       = NewError("is not divisible", message.NotDivisible)
+	ErrNotDivisibleCount  = NewError("not divisible count", message.NotDivisibleCount)
+	ErrNotEqual           = NewError("is not equal", message.NotEqual)
+	ErrNotExactCount      = NewError("not exact count", message.NotExactCount)
+	ErrNotExactLength     = NewError("not exact length", message.NotExactLength)
+	ErrNotFalse           = NewError("is not false", message.NotFalse)
+	ErrNotFinite          = NewError("is not finite", message.NotFinite)
+	ErrNotInRange         = NewError("is not in range", message.NotInRange)
+	ErrNotInteger         = NewError("is not an integer", message.NotInteger)
+	ErrNotNaN             = NewError("is not NaN", message.NotNaN)
+	ErrNotNegative        = NewError("is not negative", message.NotNegative)
+	ErrNotNegativeOrZero  = NewError("is not negative or zero", message.NotNegativeOrZero)
+	ErrNotNil             = NewError("is not nil", message.NotNil)
+	ErrNotNumeric         = NewError("is not numeric", message.NotNumeric)
+	ErrNotPositive        = NewError("is not positive", message.NotPositive)
+	ErrNotPositiveOrZero  = NewError("is not positive or zero", message.NotPositiveOrZero)
+	ErrNotTrue            = NewError("is not true", message.NotTrue)
+	ErrNotUnique          = NewError("is not unique", message.NotUnique)
+	ErrNotValid           = NewError("is not valid", message.NotValid)
+	ErrNotWeekday         = NewError("is not weekday", message.NotWeekday)
+	ErrNotWeekend         = NewError("is not weekend", message.NotWeekend)
+	ErrProhibitedIP       = NewError("is prohibited IP", message.ProhibitedIP)
+	ErrProhibitedURL      = NewError("is prohibited URL", message.ProhibitedURL)
+	ErrTooEarly           = NewError("is too early", message.TooEarly)
+	ErrTooEarlyOrEqual    = NewError("is too early or equal", message.TooEarlyOrEqual)
+	ErrTooFewElements     = NewError("too few elements", message.TooFewElements)
+	ErrTooFewWords        = NewError("too few words", message.TooFewWords)
+	ErrTooHigh            = NewError("is too high", message.TooHigh)
+	ErrTooHighOrEqual     = NewError("is too high or equal", message.TooHighOrEqual)
+	ErrTooLate            = NewError("is too late", message.TooLate)
+	ErrTooLateOrEqual     = NewError("is too late or equal", message.TooLateOrEqual)
+	ErrTooLong            = NewError("is too long", message.TooLong)
+	ErrTooLongBytes       = NewError("is too long in bytes", message.TooLongBytes)
+	ErrTooLow             = NewError("is too low", message.TooLow)
+	ErrTooLowOrEqual      = NewError("is too low or equal", message.TooLowOrEqual)
+	ErrTooManyElements    = NewError("too many elements", message.TooManyElements)
+	ErrTooManyWords       = NewError("too many words", message.TooManyWords)
+	ErrTooShort           = NewError("is too short", message.TooShort)
+	ErrTooShortBytes      = NewError("is too short in bytes", message.TooShortBytes)
+	ErrWeakPassword       = NewError("weak password", message.WeakPassword)
+	ErrWrongFieldCount    = NewError("wrong field count", message.WrongFieldCount)
 )
 
 type Error struct {
-	code    string
-	message string
+	code        string
+	description string
+	message     string
 }
 
 func NewError(code, message string) *Error {
-	return &Error{code: code, message: message}
+	return &Error{code: code, description: code, message: message}
 }
 
-func (err *Error) Error() string { return err.code }
+func NewErrorWithCode(code, description, message string) *Error {
+	return &Error{code: code, description: description, message: message}
+}
+
+func (err *Error) Error() string { return err.description }
+
+func (err *Error) Code() string { return err.code }
 
 func (err *Error) Message() string { return err.message }
 
+func (err *Error) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Code    string `json:"code"`
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}{
+		Code:    err.code,
+		Error:   err.description,
+		Message: err.message,
+	})
+}
+
 type ConstraintError struct {
 	ConstraintName string
 	Path           *PropertyPath
 	Description    string
+	Err            error
 }
 
 func (err *ConstraintError) Error() string {
@@ -80,9 +119,17 @@ func (err *ConstraintError) Error() string {
 
 	s.WriteString(": " + err.Description)
 
+	if err.Err != nil {
+		s.WriteString(": " + err.Err.Error())
+	}
+
 	return s.String()
 }
 
+func (err *ConstraintError) Unwrap() error {
+	return err.Err
+}
+
 type ConstraintNotFoundError struct {
 	Key  string
 	Type string
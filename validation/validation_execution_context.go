@@ -1,7 +1,13 @@
 package validation
 
 import (
+	"cmp"
 	"context"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
 	"time"
 )
 
@@ -95,6 +101,37 @@ func validateCountable(count int, constraints []CountableConstraint) ValidateFun
 	}
 }
 
+func validateUintCountable(count uint64, constraints []CountableConstraint) ValidateFunc {
+	return func(ctx context.Context, validator *Validator) (*ViolationListError, error) {
+		violations := NewViolationList()
+
+		for i := range constraints {
+			var err error
+
+			switch constraint := constraints[i].(type) {
+			case UintCountableConstraint:
+				err = constraint.ValidateUint64Countable(ctx, validator, count)
+			default:
+				if count > math.MaxInt {
+					return nil, validator.CreateConstraintError(
+						"CountableConstraint",
+						"count "+strconv.FormatUint(count, 10)+" overflows int",
+					)
+				}
+
+				err = constraint.ValidateCountable(ctx, validator, int(count))
+			}
+
+			err = violations.AppendFromError(err)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return violations, nil
+	}
+}
+
 func validateTime(value *time.Time, constraints []TimeConstraint) ValidateFunc {
 	return func(ctx context.Context, validator *Validator) (*ViolationListError, error) {
 		violations := NewViolationList()
@@ -110,6 +147,21 @@ func validateTime(value *time.Time, constraints []TimeConstraint) ValidateFunc {
 	}
 }
 
+func validateReader(value io.Reader, constraints []ReaderConstraint) ValidateFunc {
+	return func(ctx context.Context, validator *Validator) (*ViolationListError, error) {
+		violations := NewViolationList()
+
+		for i := range constraints {
+			err := violations.AppendFromError(constraints[i].ValidateReader(ctx, validator, value))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return violations, nil
+	}
+}
+
 func validateEachString(values []string, constraints []StringConstraint) ValidateFunc {
 	return func(ctx context.Context, validator *Validator) (*ViolationListError, error) {
 		violations := NewViolationList()
@@ -148,6 +200,62 @@ func validateEach[T any](
 	}
 }
 
+func validateEachMap[T any](
+	values map[string]T,
+	validateFunc func(ctx context.Context, validator *Validator, value *T) error,
+) ValidateFunc {
+	return func(ctx context.Context, validator *Validator) (*ViolationListError, error) {
+		violations := NewViolationList()
+
+		for _, key := range slices.Sorted(maps.Keys(values)) {
+			value := values[key]
+			if err := validateFunc(ctx, validator.AtProperty(key), &value); err != nil {
+				if vErr := violations.AppendFromError(err); vErr != nil {
+					return nil, vErr
+				}
+			}
+		}
+
+		return violations, nil
+	}
+}
+
+func validateEachStringMap(values map[string]string, constraints []StringConstraint) ValidateFunc {
+	return validateEachMap(
+		values,
+		func(ctx context.Context, validator *Validator, value *string) error {
+			violations := NewViolationList()
+
+			for _, constraint := range constraints {
+				err := violations.AppendFromError(constraint.ValidateString(ctx, validator, value))
+				if err != nil {
+					return err
+				}
+			}
+
+			return violations.AsError()
+		},
+	)
+}
+
+func validateEachComparableMap[T comparable](
+	values map[string]T,
+	constraints []ComparableConstraint[T],
+) ValidateFunc {
+	return validateEachMap(values, func(ctx context.Context, validator *Validator, value *T) error {
+		violations := NewViolationList()
+
+		for _, constraint := range constraints {
+			err := violations.AppendFromError(constraint.ValidateComparable(ctx, validator, value))
+			if err != nil {
+				return err
+			}
+		}
+
+		return violations.AsError()
+	})
+}
+
 func validateEachNumber[T Numeric](values []T, constraints []NumberConstraint[T]) ValidateFunc {
 	return validateEach(values, func(ctx context.Context, validator *Validator, value *T) error {
 		for _, constraint := range constraints {
@@ -175,6 +283,24 @@ func validateEachComparable[T comparable](
 	})
 }
 
+func validateEachComparables[T comparable](
+	values [][]T,
+	constraints []ComparablesConstraint[T],
+) ValidateFunc {
+	return validateEach(values, func(ctx context.Context, validator *Validator, value *[]T) error {
+		violations := NewViolationList()
+
+		for _, constraint := range constraints {
+			err := violations.AppendFromError(constraint.ValidateComparables(ctx, validator, *value))
+			if err != nil {
+				return err
+			}
+		}
+
+		return violations.AsError()
+	})
+}
+
 func validateIt(value Validatable) ValidateFunc {
 	return func(ctx context.Context, validator *Validator) (*ViolationListError, error) {
 		err := value.Validate(ctx, validator)
@@ -239,6 +365,24 @@ func validateComparable[T comparable](
 	}
 }
 
+func validateOrdered[T cmp.Ordered](
+	value *T,
+	constraints []OrderedComparableConstraint[T],
+) ValidateFunc {
+	return func(ctx context.Context, validator *Validator) (*ViolationListError, error) {
+		violations := NewViolationList()
+
+		for i := range constraints {
+			err := violations.AppendFromError(constraints[i].ValidateOrdered(ctx, validator, value))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return violations, nil
+	}
+}
+
 func validateComparables[T comparable](
 	values []T,
 	constraints []ComparablesConstraint[T],
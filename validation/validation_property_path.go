@@ -68,6 +68,22 @@ func (path *PropertyPath) WithIndex(index int) *PropertyPath {
 	}
 }
 
+func (path *PropertyPath) Parent() *PropertyPath {
+	if path == nil {
+		return nil
+	}
+
+	return path.parent
+}
+
+func (path *PropertyPath) Last() PropertyPathElement {
+	if path == nil {
+		return nil
+	}
+
+	return path.value
+}
+
 func (path *PropertyPath) Elements() []PropertyPathElement {
 	if path == nil || path.value == nil {
 		return nil
@@ -100,6 +116,35 @@ func (path *PropertyPath) Len() int {
 	return length
 }
 
+func (path *PropertyPath) Equal(other *PropertyPath) bool {
+	for path != nil && other != nil {
+		if !isEqualElement(path.value, other.value) {
+			return false
+		}
+
+		path, other = path.parent, other.parent
+	}
+
+	return path == nil && other == nil
+}
+
+func (path *PropertyPath) HasPrefix(prefix *PropertyPath) bool {
+	elements := path.Elements()
+	prefixElements := prefix.Elements()
+
+	if len(prefixElements) > len(elements) {
+		return false
+	}
+
+	for i := range prefixElements {
+		if !isEqualElement(elements[i], prefixElements[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (path *PropertyPath) String() string {
 	elements := path.Elements()
 	count := 0
@@ -154,6 +199,14 @@ func (path *PropertyPath) UnmarshalText(text []byte) error {
 	return nil
 }
 
+func isEqualElement(a, b PropertyPathElement) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.IsIndex() == b.IsIndex() && a.String() == b.String()
+}
+
 func isIdentifier(s string) bool {
 	if len(s) == 0 {
 		return false
@@ -0,0 +1,200 @@
+package validation
+
+import (
+	"context"
+	"maps"
+	"math"
+	"slices"
+	"time"
+)
+
+type SchemaField func(value any, exists bool) []Argument
+
+type Schema struct {
+	fields        map[string]SchemaField
+	forbidUnknown bool
+}
+
+func NewSchema() Schema {
+	return Schema{fields: make(map[string]SchemaField)}
+}
+
+func (schema Schema) With(key string, field SchemaField) Schema {
+	fields := maps.Clone(schema.fields)
+	if fields == nil {
+		fields = make(map[string]SchemaField)
+	}
+
+	fields[key] = field
+	schema.fields = fields
+
+	return schema
+}
+
+func (schema Schema) WithForbidUnknown() Schema {
+	schema.forbidUnknown = true
+	return schema
+}
+
+func ValidateMap(
+	ctx context.Context,
+	data map[string]any,
+	schema Schema,
+	validator *Validator,
+) error {
+	arguments := make([]Argument, 0, len(schema.fields))
+
+	for _, key := range slices.Sorted(maps.Keys(schema.fields)) {
+		value, exists := data[key]
+		arguments = append(arguments, AtProperty(key, schema.fields[key](value, exists)...))
+	}
+
+	if schema.forbidUnknown {
+		for _, key := range slices.Sorted(maps.Keys(data)) {
+			if _, ok := schema.fields[key]; !ok {
+				arguments = append(arguments, CheckProperty(key, false))
+			}
+		}
+	}
+
+	return validator.Validate(ctx, arguments...)
+}
+
+func StringField(constraints ...StringConstraint) SchemaField {
+	return func(value any, exists bool) []Argument {
+		if !exists || value == nil {
+			return []Argument{NilString(nil, constraints...)}
+		}
+
+		s, ok := value.(string)
+		if !ok {
+			return []Argument{Check(false)}
+		}
+
+		return []Argument{String(s, constraints...)}
+	}
+}
+
+func BoolField(constraints ...BoolConstraint) SchemaField {
+	return func(value any, exists bool) []Argument {
+		if !exists || value == nil {
+			return []Argument{NilBool(nil, constraints...)}
+		}
+
+		b, ok := value.(bool)
+		if !ok {
+			return []Argument{Check(false)}
+		}
+
+		return []Argument{Bool(b, constraints...)}
+	}
+}
+
+func NumberField[T Numeric](constraints ...NumberConstraint[T]) SchemaField {
+	return func(value any, exists bool) []Argument {
+		if !exists || value == nil {
+			return []Argument{NilNumber(nil, constraints...)}
+		}
+
+		n, ok := coerceNumber[T](value)
+		if !ok {
+			return []Argument{Check(false)}
+		}
+
+		return []Argument{Number(n, constraints...)}
+	}
+}
+
+func TimeField(layout string, constraints ...TimeConstraint) SchemaField {
+	return func(value any, exists bool) []Argument {
+		if !exists || value == nil {
+			return []Argument{NilTime(nil, constraints...)}
+		}
+
+		switch v := value.(type) {
+		case time.Time:
+			return []Argument{Time(v, constraints...)}
+		case string:
+			t, err := time.Parse(layout, v)
+			if err != nil {
+				return []Argument{Check(false)}
+			}
+
+			return []Argument{Time(t, constraints...)}
+		default:
+			return []Argument{Check(false)}
+		}
+	}
+}
+
+func coerceNumber[T Numeric](value any) (T, bool) {
+	switch v := value.(type) {
+	case T:
+		return v, true
+	case float64:
+		return coerceFloat[T](v)
+	case float32:
+		return coerceFloat[T](float64(v))
+	case int:
+		return coerceInt[T](int64(v))
+	case int8:
+		return coerceInt[T](int64(v))
+	case int16:
+		return coerceInt[T](int64(v))
+	case int32:
+		return coerceInt[T](int64(v))
+	case int64:
+		return coerceInt[T](v)
+	case uint:
+		return coerceUint[T](uint64(v))
+	case uint8:
+		return coerceUint[T](uint64(v))
+	case uint16:
+		return coerceUint[T](uint64(v))
+	case uint32:
+		return coerceUint[T](uint64(v))
+	case uint64:
+		return coerceUint[T](v)
+	default:
+		return 0, false
+	}
+}
+
+func coerceFloat[T Numeric](v float64) (T, bool) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, false
+	}
+
+	n := T(v)
+	if isFloatType[T]() {
+		return n, !math.IsInf(float64(n), 0)
+	}
+
+	return n, float64(n) == v
+}
+
+func coerceInt[T Numeric](v int64) (T, bool) {
+	if v < 0 && isUnsignedType[T]() {
+		return 0, false
+	}
+
+	n := T(v)
+
+	return n, int64(n) == v
+}
+
+func coerceUint[T Numeric](v uint64) (T, bool) {
+	n := T(v)
+
+	return n, n >= 0 && uint64(n) == v
+}
+
+func isFloatType[T Numeric]() bool {
+	half := 0.5
+	return T(half) != 0
+}
+
+func isUnsignedType[T Numeric]() bool {
+	var zero T
+	return zero-1 > zero
+}
@@ -2,7 +2,9 @@ package validation
 
 import (
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type TemplateParameter struct {
@@ -12,10 +14,36 @@ type TemplateParameter struct {
 
 type TemplateParameterList []TemplateParameter
 
+func IntParam(key string, value int) TemplateParameter {
+	return TemplateParameter{Key: key, Value: strconv.Itoa(value)}
+}
+
+func FloatParam(key string, value float64, prec int) TemplateParameter {
+	return TemplateParameter{Key: key, Value: strconv.FormatFloat(value, 'f', prec, 64)}
+}
+
+func StringParam(key, value string) TemplateParameter {
+	return TemplateParameter{Key: key, Value: value}
+}
+
+func TimeParam(key string, value time.Time, layout string) TemplateParameter {
+	return TemplateParameter{Key: key, Value: value.Format(layout)}
+}
+
 func (params TemplateParameterList) Prepend(parameters ...TemplateParameter) TemplateParameterList {
 	return append(parameters, params...)
 }
 
+func (params TemplateParameterList) Get(key string) (string, bool) {
+	for _, p := range params {
+		if p.Key == key {
+			return p.Value, true
+		}
+	}
+
+	return "", false
+}
+
 func renderMessage(template string, parameters []TemplateParameter) string {
 	sort.SliceStable(parameters, func(i, j int) bool {
 		return len(parameters[i].Key) > len(parameters[j].Key)
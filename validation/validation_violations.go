@@ -2,12 +2,15 @@ package validation
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"strconv"
 	"strings"
+
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -21,6 +24,7 @@ type Violation interface {
 	Message() string
 	MessageTemplate() string
 	Parameters() []TemplateParameter
+	Parameter(key string) (string, bool)
 	PropertyPath() *PropertyPath
 }
 
@@ -33,6 +37,61 @@ type ViolationFactory interface {
 	) Violation
 }
 
+// ContextualViolationFactory is checked on the factory passed to SetViolationFactory
+// and receives the validation context, e.g. to translate templates to a locale stored in it.
+type ContextualViolationFactory interface {
+	ViolationFactory
+	CreateViolationWithContext(
+		ctx context.Context,
+		err error,
+		messageTemplate string,
+		parameters []TemplateParameter,
+		propertyPath *PropertyPath,
+	) Violation
+}
+
+type NewContextualViolationFunc func(
+	ctx context.Context,
+	err error,
+	messageTemplate string,
+	parameters []TemplateParameter,
+	propertyPath *PropertyPath,
+) Violation
+
+func (f NewContextualViolationFunc) CreateViolation(
+	err error,
+	messageTemplate string,
+	parameters []TemplateParameter,
+	propertyPath *PropertyPath,
+) Violation {
+	return f(context.Background(), err, messageTemplate, parameters, propertyPath)
+}
+
+func (f NewContextualViolationFunc) CreateViolationWithContext(
+	ctx context.Context,
+	err error,
+	messageTemplate string,
+	parameters []TemplateParameter,
+	propertyPath *PropertyPath,
+) Violation {
+	return f(ctx, err, messageTemplate, parameters, propertyPath)
+}
+
+func createViolation(
+	ctx context.Context,
+	factory ViolationFactory,
+	err error,
+	messageTemplate string,
+	parameters []TemplateParameter,
+	propertyPath *PropertyPath,
+) Violation {
+	if f, ok := factory.(ContextualViolationFactory); ok && ctx != nil {
+		return f.CreateViolationWithContext(ctx, err, messageTemplate, parameters, propertyPath)
+	}
+
+	return factory.CreateViolation(err, messageTemplate, parameters, propertyPath)
+}
+
 type NewViolationFunc func(
 	err error,
 	messageTemplate string,
@@ -55,6 +114,11 @@ type ViolationListError struct {
 	len   int
 }
 
+type GroupedViolations struct {
+	ByProperty map[string][]Violation
+	Order      []string
+}
+
 type ViolationListElementError struct {
 	next      *ViolationListElementError
 	violation Violation
@@ -67,6 +131,13 @@ func NewViolationList(violations ...Violation) *ViolationListError {
 	return list
 }
 
+func NewViolationListFromSlice(violations []Violation) *ViolationListError {
+	list := &ViolationListError{}
+	list.Append(violations...)
+
+	return list
+}
+
 func (list *ViolationListError) Len() int {
 	if list == nil {
 		return 0
@@ -75,6 +146,14 @@ func (list *ViolationListError) Len() int {
 	return list.len
 }
 
+func (list *ViolationListError) IsEmpty() bool {
+	return list.Len() == 0
+}
+
+func (list *ViolationListError) NonEmpty() bool {
+	return list.Len() > 0
+}
+
 func (list *ViolationListError) ForEach(f func(i int, violation Violation) error) error {
 	if list == nil {
 		return nil
@@ -93,6 +172,31 @@ func (list *ViolationListError) ForEach(f func(i int, violation Violation) error
 	return nil
 }
 
+func (list *ViolationListError) ForEachAtPath(
+	path *PropertyPath,
+	f func(i int, violation Violation) error,
+) error {
+	if list == nil {
+		return nil
+	}
+
+	i := 0
+	for e := list.first; e != nil; e = e.next {
+		if !e.violation.PropertyPath().Equal(path) {
+			continue
+		}
+
+		err := f(i, e.violation)
+		if err != nil {
+			return err
+		}
+
+		i++
+	}
+
+	return nil
+}
+
 func (list *ViolationListError) First() *ViolationListElementError {
 	return list.first
 }
@@ -132,6 +236,19 @@ func (list *ViolationListError) Join(violations *ViolationListError) {
 	list.len += violations.len
 }
 
+func (list *ViolationListError) Clone() *ViolationListError {
+	clone := &ViolationListError{}
+	if list == nil {
+		return clone
+	}
+
+	for e := list.first; e != nil; e = e.next {
+		clone.Append(e.violation)
+	}
+
+	return clone
+}
+
 func (list *ViolationListError) Error() string {
 	if list == nil || list.len == 0 {
 		return "the list of violations is empty, it looks like you forgot to use the AsError method somewhere"
@@ -157,6 +274,20 @@ func (list *ViolationListError) Format(f fmt.State, verb rune) {
 	}
 }
 
+func (list *ViolationListError) WriteTo(w io.Writer) (int64, error) {
+	if list == nil || list.len == 0 {
+		return 0, nil
+	}
+
+	if list.len == 1 {
+		n, err := io.WriteString(w, list.first.violation.Error())
+
+		return int64(n), err
+	}
+
+	return list.writeTo(w, " ")
+}
+
 func (list *ViolationListError) toString(delimiter string) string {
 	if list == nil || list.len == 0 {
 		return ""
@@ -169,30 +300,56 @@ func (list *ViolationListError) toString(delimiter string) string {
 	var s strings.Builder
 
 	s.Grow(initialBufferSize * list.len)
-	s.WriteString("violations:")
+	_, _ = list.writeTo(&s, delimiter)
+
+	return s.String()
+}
+
+func (list *ViolationListError) writeTo(w io.Writer, delimiter string) (int64, error) {
+	var (
+		total int64
+		err   error
+	)
+
+	write := func(s string) {
+		if err != nil {
+			return
+		}
+
+		var n int
+		n, err = io.WriteString(w, s)
+		total += int64(n)
+	}
+
+	write("violations:")
 
 	i := 0
 
-	for e := list.first; e != nil; e = e.next {
+	for e := list.first; e != nil && err == nil; e = e.next {
 		v := e.violation
 
 		if i > 0 {
-			s.WriteString(";")
+			write(";")
 		}
 
-		s.WriteString(delimiter)
-		s.WriteString("#" + strconv.Itoa(i))
+		write(delimiter)
+		write("#")
+		write(strconv.Itoa(i))
 
 		if v.PropertyPath() != nil {
-			s.WriteString(` at "` + v.PropertyPath().String() + `"`)
+			write(` at "`)
+			write(v.PropertyPath().String())
+			write(`"`)
 		}
 
-		s.WriteString(`: "` + v.Message() + `"`)
+		write(`: "`)
+		write(v.Message())
+		write(`"`)
 
 		i++
 	}
 
-	return s.String()
+	return total, err
 }
 
 func (list *ViolationListError) AppendFromError(err error) error {
@@ -237,6 +394,89 @@ func (list *ViolationListError) Filter(errs ...error) *ViolationListError {
 	return filtered
 }
 
+func (list *ViolationListError) FilterByPathPrefix(prefix *PropertyPath) *ViolationListError {
+	filtered := &ViolationListError{}
+	if list == nil {
+		return filtered
+	}
+
+	for e := list.first; e != nil; e = e.next {
+		if e.violation.PropertyPath().HasPrefix(prefix) {
+			filtered.Append(e.violation)
+		}
+	}
+
+	return filtered
+}
+
+func (list *ViolationListError) FilterByProperty(name string) *ViolationListError {
+	return list.FilterByPathPrefix(NewPropertyPath(PropertyName(name)))
+}
+
+func (list *ViolationListError) Limit(n int) *ViolationListError {
+	limited := &ViolationListError{}
+	if list == nil {
+		return limited
+	}
+
+	for e := list.first; e != nil && limited.len < n; e = e.next {
+		limited.Append(e.violation)
+	}
+
+	return limited
+}
+
+func (list *ViolationListError) Unique() *ViolationListError {
+	unique := &ViolationListError{}
+	if list == nil {
+		return unique
+	}
+
+	seen := make(map[string]struct{}, list.len)
+
+	for e := list.first; e != nil; e = e.next {
+		key := "\x00" + e.violation.MessageTemplate()
+		if path := e.violation.PropertyPath(); path != nil {
+			key = path.String() + key
+		}
+
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		unique.Append(e.violation)
+	}
+
+	return unique
+}
+
+func (list *ViolationListError) GroupByProperty() map[string][]Violation {
+	return list.GroupedByProperty().ByProperty
+}
+
+func (list *ViolationListError) GroupedByProperty() GroupedViolations {
+	grouped := GroupedViolations{ByProperty: make(map[string][]Violation)}
+	if list == nil {
+		return grouped
+	}
+
+	for e := list.first; e != nil; e = e.next {
+		key := ""
+		if path := e.violation.PropertyPath(); path != nil {
+			key = path.String()
+		}
+
+		if _, ok := grouped.ByProperty[key]; !ok {
+			grouped.Order = append(grouped.Order, key)
+		}
+
+		grouped.ByProperty[key] = append(grouped.ByProperty[key], e.violation)
+	}
+
+	return grouped
+}
+
 func (list *ViolationListError) AsError() error {
 	if list == nil || list.len == 0 {
 		return nil
@@ -284,6 +524,56 @@ func (list *ViolationListError) MarshalJSON() ([]byte, error) {
 	return b.Bytes(), nil
 }
 
+func (list *ViolationListError) MarshalYAML() (any, error) {
+	violations := make([]map[string]any, 0, list.Len())
+	if list == nil {
+		return violations, nil
+	}
+
+	for e := list.first; e != nil; e = e.next {
+		violations = append(violations, violationToMap(e.violation))
+	}
+
+	return violations, nil
+}
+
+func (list *ViolationListError) UnmarshalYAML(value *yaml.Node) error {
+	var data []struct {
+		PropertyPath string `yaml:"propertyPath"`
+		Error        string `yaml:"error"`
+		Message      string `yaml:"message"`
+	}
+
+	if err := value.Decode(&data); err != nil {
+		return err
+	}
+
+	factory := NewViolationFactory()
+	violations := &ViolationListError{}
+
+	for i, v := range data {
+		var path *PropertyPath
+
+		if v.PropertyPath != "" {
+			path = &PropertyPath{}
+			if err := path.UnmarshalText([]byte(v.PropertyPath)); err != nil {
+				return fmt.Errorf("unmarshal violation at %d: %w", i, err)
+			}
+		}
+
+		var err error
+		if v.Error != "" {
+			err = errors.New(v.Error)
+		}
+
+		violations.Append(factory.CreateViolation(err, v.Message, nil, path))
+	}
+
+	*list = *violations
+
+	return nil
+}
+
 func (element *ViolationListElementError) Next() *ViolationListElementError {
 	return element.next
 }
@@ -316,6 +606,10 @@ func (element *ViolationListElementError) Parameters() []TemplateParameter {
 	return element.violation.Parameters()
 }
 
+func (element *ViolationListElementError) Parameter(key string) (string, bool) {
+	return element.violation.Parameter(key)
+}
+
 func (element *ViolationListElementError) PropertyPath() *PropertyPath {
 	return element.violation.PropertyPath()
 }
@@ -348,6 +642,24 @@ func UnwrapViolationList(err error) (*ViolationListError, bool) {
 	return violations, as
 }
 
+func IsViolationWithError(err, target error) bool {
+	violation, ok := UnwrapViolation(err)
+	if !ok {
+		return false
+	}
+
+	return errors.Is(violation.Unwrap(), target)
+}
+
+func IsViolationListWithError(err, target error) bool {
+	violations, ok := UnwrapViolationList(err)
+	if !ok {
+		return false
+	}
+
+	return violations.Is(target)
+}
+
 type internalViolationError struct {
 	err             error
 	propertyPath    *PropertyPath
@@ -389,6 +701,10 @@ func (v *internalViolationError) MessageTemplate() string { return v.messageTemp
 
 func (v *internalViolationError) Parameters() []TemplateParameter { return v.parameters }
 
+func (v *internalViolationError) Parameter(key string) (string, bool) {
+	return TemplateParameterList(v.parameters).Get(key)
+}
+
 func (v *internalViolationError) PropertyPath() *PropertyPath { return v.propertyPath }
 
 func (v *internalViolationError) MarshalJSON() ([]byte, error) {
@@ -407,6 +723,24 @@ func (v *internalViolationError) MarshalJSON() ([]byte, error) {
 	return json.Marshal(data)
 }
 
+func (v *internalViolationError) MarshalYAML() (any, error) {
+	return violationToMap(v), nil
+}
+
+func violationToMap(violation Violation) map[string]any {
+	data := map[string]any{"message": violation.Message()}
+
+	if violation.PropertyPath() != nil {
+		data["propertyPath"] = violation.PropertyPath().String()
+	}
+
+	if err := violation.Unwrap(); err != nil {
+		data["error"] = err.Error()
+	}
+
+	return data
+}
+
 type BuiltinViolationFactory struct{}
 
 func NewViolationFactory() *BuiltinViolationFactory {
@@ -430,8 +764,19 @@ func (factory *BuiltinViolationFactory) CreateViolation(
 	}
 }
 
+func (factory *BuiltinViolationFactory) CreateViolationWithContext(
+	_ context.Context,
+	err error,
+	messageTemplate string,
+	parameters []TemplateParameter,
+	propertyPath *PropertyPath,
+) Violation {
+	return factory.CreateViolation(err, messageTemplate, parameters, propertyPath)
+}
+
 type ViolationBuilder struct {
 	err              error
+	ctx              context.Context
 	violationFactory ViolationFactory
 	propertyPath     *PropertyPath
 	messageTemplate  string
@@ -447,9 +792,16 @@ func (b *ViolationBuilder) BuildViolation(err error, message string) *ViolationB
 		err:              err,
 		messageTemplate:  message,
 		violationFactory: b.violationFactory,
+		ctx:              b.ctx,
 	}
 }
 
+func (b *ViolationBuilder) SetContext(ctx context.Context) *ViolationBuilder {
+	b.ctx = ctx
+
+	return b
+}
+
 func (b *ViolationBuilder) SetPropertyPath(path *PropertyPath) *ViolationBuilder {
 	b.propertyPath = path
 
@@ -487,7 +839,9 @@ func (b *ViolationBuilder) AtIndex(index int) *ViolationBuilder {
 }
 
 func (b *ViolationBuilder) Create() Violation {
-	return b.violationFactory.CreateViolation(
+	return createViolation(
+		b.ctx,
+		b.violationFactory,
 		b.err,
 		b.messageTemplate,
 		b.parameters,
@@ -496,6 +850,7 @@ func (b *ViolationBuilder) Create() Violation {
 }
 
 type ViolationListBuilder struct {
+	ctx              context.Context
 	violations       *ViolationListError
 	violationFactory ViolationFactory
 
@@ -534,6 +889,12 @@ func (b *ViolationListBuilder) AddViolation(
 	return b.add(err, message, nil, b.propertyPath.With(path...))
 }
 
+func (b *ViolationListBuilder) SetContext(ctx context.Context) *ViolationListBuilder {
+	b.ctx = ctx
+
+	return b
+}
+
 func (b *ViolationListBuilder) SetPropertyPath(path *PropertyPath) *ViolationListBuilder {
 	b.propertyPath = path
 
@@ -568,7 +929,9 @@ func (b *ViolationListBuilder) add(
 	parameters []TemplateParameter,
 	path *PropertyPath,
 ) *ViolationListBuilder {
-	b.violations.Append(b.violationFactory.CreateViolation(
+	b.violations.Append(createViolation(
+		b.ctx,
+		b.violationFactory,
 		err,
 		template,
 		parameters,
@@ -0,0 +1,73 @@
+package validation_test
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"line/validation"
+)
+
+func TestViolationListError_YAMLRoundTrip(t *testing.T) {
+	factory := validation.NewViolationFactory()
+	list := validation.NewViolationList(
+		factory.CreateViolation(
+			validation.ErrNotBlank,
+			validation.ErrNotBlank.Message(),
+			nil,
+			validation.NewPropertyPath(validation.PropertyName("name")),
+		),
+		factory.CreateViolation(
+			validation.ErrTooShort,
+			"This value is too short.",
+			nil,
+			validation.NewPropertyPath(validation.PropertyName("tags"), validation.ArrayIndex(1)),
+		),
+		factory.CreateViolation(validation.ErrNotValid, validation.ErrNotValid.Message(), nil, nil),
+	)
+
+	data, err := yaml.Marshal(list)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	var decoded validation.ViolationListError
+	if err := yaml.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	want := list.AsSlice()
+	got := decoded.AsSlice()
+	if len(got) != len(want) {
+		t.Fatalf("got %d violations, want %d", len(got), len(want))
+	}
+
+	for i := range want {
+		if got[i].Message() != want[i].Message() {
+			t.Errorf("violation %d: message %q, want %q", i, got[i].Message(), want[i].Message())
+		}
+		if got[i].Error() != want[i].Error() {
+			t.Errorf("violation %d: error %q, want %q", i, got[i].Error(), want[i].Error())
+		}
+		if got[i].Unwrap().Error() != want[i].Unwrap().Error() {
+			t.Errorf(
+				"violation %d: cause %q, want %q",
+				i,
+				got[i].Unwrap().Error(),
+				want[i].Unwrap().Error(),
+			)
+		}
+		if !got[i].PropertyPath().Equal(want[i].PropertyPath()) {
+			t.Errorf(
+				"violation %d: path %q, want %q",
+				i,
+				got[i].PropertyPath().String(),
+				want[i].PropertyPath().String(),
+			)
+		}
+	}
+
+	if got[2].PropertyPath() != nil {
+		t.Errorf("violation 2: path %q, want nil", got[2].PropertyPath().String())
+	}
+}
@@ -2,6 +2,7 @@ package validation
 
 import (
 	"context"
+	"errors"
 	"slices"
 	"time"
 )
@@ -9,15 +10,19 @@ import (
 type Validator struct {
 	propertyPath     *PropertyPath
 	violationFactory ViolationFactory
+	defaultGroup     string
 	groups           []string
+	maxViolations    int
 }
 
 type ValidatorOptions struct {
 	violationFactory ViolationFactory
+	defaultGroup     string
+	maxViolations    int
 }
 
 func newValidatorOptions() *ValidatorOptions {
-	return &ValidatorOptions{}
+	return &ValidatorOptions{defaultGroup: DefaultGroup}
 }
 
 type ValidatorOption func(options *ValidatorOptions) error
@@ -39,6 +44,8 @@ func NewValidator(options ...ValidatorOption) (*Validator, error) {
 
 	validator := &Validator{
 		violationFactory: opts.violationFactory,
+		defaultGroup:     opts.defaultGroup,
+		maxViolations:    opts.maxViolations,
 	}
 
 	return validator, nil
@@ -52,6 +59,26 @@ func SetViolationFactory(factory ViolationFactory) ValidatorOption {
 	}
 }
 
+func SetDefaultGroup(name string) ValidatorOption {
+	return func(options *ValidatorOptions) error {
+		if name == "" {
+			return errors.New("default group name must not be empty")
+		}
+
+		options.defaultGroup = name
+
+		return nil
+	}
+}
+
+func MaxViolations(n int) ValidatorOption {
+	return func(options *ValidatorOptions) error {
+		options.maxViolations = n
+
+		return nil
+	}
+}
+
 func (validator *Validator) Validate(ctx context.Context, arguments ...Argument) error {
 	execContext := &executionContext{}
 	for _, argument := range arguments {
@@ -69,6 +96,10 @@ func (validator *Validator) Validate(ctx context.Context, arguments ...Argument)
 		violations.Join(vs)
 	}
 
+	if validator.maxViolations > 0 && violations.len > validator.maxViolations {
+		violations = violations.Limit(validator.maxViolations)
+	}
+
 	return violations.AsError()
 }
 
@@ -140,6 +171,14 @@ func (validator *Validator) ValidateIt(ctx context.Context, validatable Validata
 	return validator.Validate(ctx, Valid(validatable))
 }
 
+func (validator *Validator) ValidateItWith(
+	ctx context.Context,
+	value Validatable,
+	extra ...Argument,
+) error {
+	return validator.Validate(ctx, append([]Argument{Valid(value)}, extra...)...)
+}
+
 func (validator *Validator) WithGroups(groups ...string) *Validator {
 	v := validator.copy()
 	v.groups = groups
@@ -147,20 +186,38 @@ func (validator *Validator) WithGroups(groups ...string) *Validator {
 	return v
 }
 
+func (validator *Validator) WithAdditionalGroups(groups ...string) *Validator {
+	v := validator.copy()
+	if len(v.groups) == 0 {
+		v.groups = append([]string{v.defaultGroup}, groups...)
+	} else {
+		v.groups = slices.Concat(v.groups, groups)
+	}
+
+	return v
+}
+
+func (validator *Validator) ResetGroups() *Validator {
+	v := validator.copy()
+	v.groups = nil
+
+	return v
+}
+
 func (validator *Validator) IsAppliedForGroups(groups ...string) bool {
 	if len(validator.groups) == 0 {
 		if len(groups) == 0 {
 			return true
 		}
 
-		if slices.Contains(groups, DefaultGroup) {
+		if slices.Contains(groups, validator.defaultGroup) {
 			return true
 		}
 	}
 
 	for _, g1 := range validator.groups {
 		if len(groups) == 0 {
-			if g1 == DefaultGroup {
+			if g1 == validator.defaultGroup {
 				return true
 			}
 		}
@@ -188,6 +245,21 @@ func (validator *Validator) CreateConstraintError(
 	}
 }
 
+func (validator *Validator) CreateConstraintErrorWithCause(
+	constraintName,
+	description string,
+	cause error,
+) *ConstraintError {
+	err := validator.CreateConstraintError(constraintName, description)
+	err.Err = cause
+
+	return err
+}
+
+func (validator *Validator) PropertyPath() *PropertyPath {
+	return validator.propertyPath
+}
+
 func (validator *Validator) At(path ...PropertyPathElement) *Validator {
 	v := validator.copy()
 	v.propertyPath = v.propertyPath.With(path...)
@@ -195,6 +267,17 @@ func (validator *Validator) At(path ...PropertyPathElement) *Validator {
 	return v
 }
 
+func (validator *Validator) WithPropertyPath(path *PropertyPath) *Validator {
+	v := validator.copy()
+	v.propertyPath = path
+
+	return v
+}
+
+func (validator *Validator) AtRoot() *Validator {
+	return validator.WithPropertyPath(nil)
+}
+
 func (validator *Validator) AtProperty(name string) *Validator {
 	v := validator.copy()
 	v.propertyPath = v.propertyPath.WithProperty(name)
@@ -224,14 +307,14 @@ func (validator *Validator) BuildViolation(
 	message string,
 ) *ViolationBuilder {
 	b := NewViolationBuilder(validator.violationFactory).BuildViolation(err, message)
-	b = b.SetPropertyPath(validator.propertyPath)
+	b = b.SetContext(ctx).SetPropertyPath(validator.propertyPath)
 
 	return b
 }
 
 func (validator *Validator) BuildViolationList(ctx context.Context) *ViolationListBuilder {
 	b := NewViolationListBuilder(validator.violationFactory)
-	b = b.SetPropertyPath(validator.propertyPath)
+	b = b.SetContext(ctx).SetPropertyPath(validator.propertyPath)
 
 	return b
 }
@@ -240,6 +323,8 @@ func (validator *Validator) copy() *Validator {
 	return &Validator{
 		propertyPath:     validator.propertyPath,
 		violationFactory: validator.violationFactory,
+		defaultGroup:     validator.defaultGroup,
 		groups:           validator.groups,
+		maxViolations:    validator.maxViolations,
 	}
 }
@@ -16,6 +16,27 @@ func (f ValidatableFunc) Validate(ctx context.Context, validator *Validator) err
 	return f(ctx, validator)
 }
 
+func (f ValidatableFunc) Name(name string) NamedValidatableFunc {
+	return NamedValidatableFunc{name: name, validate: f}
+}
+
+type NamedValidatableFunc struct {
+	validate ValidatableFunc
+	name     string
+}
+
+func (f NamedValidatableFunc) Validate(ctx context.Context, validator *Validator) error {
+	return f.validate(ctx, validator)
+}
+
+func (f NamedValidatableFunc) Name() string {
+	return f.name
+}
+
+func (f NamedValidatableFunc) String() string {
+	return f.name
+}
+
 func Filter(violations ...error) error {
 	list := &ViolationListError{}
 
@@ -28,3 +49,23 @@ func Filter(violations ...error) error {
 
 	return list.AsError()
 }
+
+func FilterLists(lists ...*ViolationListError) *ViolationListError {
+	result := NewViolationList()
+
+	for _, list := range lists {
+		result.Join(list.Clone())
+	}
+
+	if result.IsEmpty() {
+		return nil
+	}
+
+	return result
+}
+
+func MustValidate(ctx context.Context, validator *Validator, arguments ...Argument) {
+	if err := validator.Validate(ctx, arguments...); err != nil {
+		panic(err)
+	}
+}